@@ -0,0 +1,116 @@
+package optional
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// AtomicOptional is an Optional that can be shared between goroutines.
+// Loads are lock-free; writes are serialized so that conditional updates are atomic.
+// The zero value is an empty AtomicOptional ready to use.
+// An AtomicOptional must not be copied after first use.
+type AtomicOptional[T any] struct {
+	v     atomic.Pointer[Optional[T]]
+	mu    sync.Mutex
+	ready chan struct{} // closed when a value is stored; nil when nobody is waiting
+}
+
+// NewAtomicOptional creates an AtomicOptional holding opt
+func NewAtomicOptional[T any](opt Optional[T]) *AtomicOptional[T] {
+	a := &AtomicOptional[T]{}
+	a.v.Store(&opt)
+	return a
+}
+
+// Load returns the current Optional
+func (a *AtomicOptional[T]) Load() Optional[T] {
+	if p := a.v.Load(); p != nil {
+		return *p
+	}
+	return None[T]()
+}
+
+// Store sets the value, making it present
+func (a *AtomicOptional[T]) Store(value T) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.store(Some(value))
+}
+
+// Swap sets the value and returns the previous Optional
+func (a *AtomicOptional[T]) Swap(value T) Optional[T] {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	old := a.Load()
+	a.store(Some(value))
+	return old
+}
+
+// Clear empties the AtomicOptional
+func (a *AtomicOptional[T]) Clear() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.store(None[T]())
+}
+
+// SetIfEmpty stores the value only if the AtomicOptional is empty,
+// and reports whether it did
+func (a *AtomicOptional[T]) SetIfEmpty(value T) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.Load().present {
+		return false
+	}
+	a.store(Some(value))
+	return true
+}
+
+// Wait blocks until a value is present and returns it,
+// or returns ctx.Err() if the context is done first
+func (a *AtomicOptional[T]) Wait(ctx context.Context) (T, error) {
+	for {
+		if opt := a.Load(); opt.present {
+			return opt.value, nil
+		}
+
+		a.mu.Lock()
+		if opt := a.Load(); opt.present {
+			a.mu.Unlock()
+			return opt.value, nil
+		}
+		if a.ready == nil {
+			a.ready = make(chan struct{})
+		}
+		ready := a.ready
+		a.mu.Unlock()
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// store replaces the Optional and wakes waiters if it is present. a.mu must be held.
+func (a *AtomicOptional[T]) store(opt Optional[T]) {
+	a.v.Store(&opt)
+	if opt.present && a.ready != nil {
+		close(a.ready)
+		a.ready = nil
+	}
+}
+
+// CompareAndSwap replaces the Optional held by a with new if it currently equals old,
+// comparing as Equal does, and reports whether it did.
+func CompareAndSwap[T comparable](a *AtomicOptional[T], old, new Optional[T]) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !Equal(a.Load(), old) {
+		return false
+	}
+	a.store(new)
+	return true
+}
@@ -0,0 +1,210 @@
+package optional
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAtomicOptional(t *testing.T) {
+	t.Run("Zero value is empty", func(t *testing.T) {
+		var a AtomicOptional[int]
+		if a.Load().IsPresent() {
+			t.Error("Zero AtomicOptional should be empty")
+		}
+	})
+
+	t.Run("NewAtomicOptional", func(t *testing.T) {
+		a := NewAtomicOptional(Some("x"))
+		if !Equal(a.Load(), Some("x")) {
+			t.Errorf("Expected Some(x), got %v", a.Load())
+		}
+	})
+
+	t.Run("Store and Clear", func(t *testing.T) {
+		var a AtomicOptional[int]
+		a.Store(0)
+		if !Equal(a.Load(), Some(0)) {
+			t.Errorf("Expected Some(0), got %v", a.Load())
+		}
+		a.Clear()
+		if a.Load().IsPresent() {
+			t.Errorf("Expected None, got %v", a.Load())
+		}
+	})
+
+	t.Run("Swap", func(t *testing.T) {
+		var a AtomicOptional[int]
+		if old := a.Swap(1); old.IsPresent() {
+			t.Errorf("Expected None, got %v", old)
+		}
+		if old := a.Swap(2); !Equal(old, Some(1)) {
+			t.Errorf("Expected Some(1), got %v", old)
+		}
+		if !Equal(a.Load(), Some(2)) {
+			t.Errorf("Expected Some(2), got %v", a.Load())
+		}
+	})
+
+	t.Run("SetIfEmpty", func(t *testing.T) {
+		var a AtomicOptional[string]
+		if !a.SetIfEmpty("first") {
+			t.Error("SetIfEmpty on empty should succeed")
+		}
+		if a.SetIfEmpty("second") {
+			t.Error("SetIfEmpty on present should fail")
+		}
+		if !Equal(a.Load(), Some("first")) {
+			t.Errorf("Expected Some(first), got %v", a.Load())
+		}
+	})
+
+	t.Run("CompareAndSwap", func(t *testing.T) {
+		var a AtomicOptional[int]
+		if !CompareAndSwap(&a, None[int](), Some(1)) {
+			t.Error("CAS from None should succeed")
+		}
+		if CompareAndSwap(&a, Some(2), Some(3)) {
+			t.Error("CAS with wrong old value should fail")
+		}
+		if !CompareAndSwap(&a, Some(1), None[int]()) {
+			t.Error("CAS to None should succeed")
+		}
+		if a.Load().IsPresent() {
+			t.Errorf("Expected None, got %v", a.Load())
+		}
+	})
+}
+
+func TestAtomicOptionalWait(t *testing.T) {
+	t.Run("Returns immediately when present", func(t *testing.T) {
+		a := NewAtomicOptional(Some(1))
+		v, err := a.Wait(context.Background())
+		if v != 1 || err != nil {
+			t.Errorf("Expected (1, nil), got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("Blocks until stored", func(t *testing.T) {
+		var a AtomicOptional[string]
+		var wg sync.WaitGroup
+		results := make([]string, 5)
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				v, err := a.Wait(context.Background())
+				if err != nil {
+					t.Errorf("Wait error: %v", err)
+				}
+				results[i] = v
+			}()
+		}
+		time.Sleep(10 * time.Millisecond)
+		a.Store("leader")
+		wg.Wait()
+		for _, r := range results {
+			if r != "leader" {
+				t.Errorf("Expected 'leader', got %q", r)
+			}
+		}
+	})
+
+	t.Run("Clear does not wake waiters", func(t *testing.T) {
+		var a AtomicOptional[int]
+		done := make(chan int)
+		go func() {
+			v, _ := a.Wait(context.Background())
+			done <- v
+		}()
+		time.Sleep(5 * time.Millisecond)
+		a.Clear()
+		select {
+		case v := <-done:
+			t.Fatalf("Wait returned %v after Clear", v)
+		case <-time.After(10 * time.Millisecond):
+		}
+		a.Store(9)
+		if v := <-done; v != 9 {
+			t.Errorf("Expected 9, got %v", v)
+		}
+	})
+
+	t.Run("Context cancellation", func(t *testing.T) {
+		var a AtomicOptional[int]
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := a.Wait(ctx)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected DeadlineExceeded, got %v", err)
+		}
+	})
+}
+
+func TestAtomicOptionalRace(t *testing.T) {
+	var a AtomicOptional[int]
+	var wins atomic.Int32
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if a.SetIfEmpty(i) {
+				wins.Add(1)
+			}
+			_ = a.Load()
+			a.Swap(i)
+			CompareAndSwap(&a, Some(i), Some(i+1))
+		}()
+	}
+	wg.Wait()
+	if wins.Load() != 1 {
+		t.Errorf("Expected exactly one SetIfEmpty to win, got %d", wins.Load())
+	}
+	if !a.Load().IsPresent() {
+		t.Error("Expected a value to be present")
+	}
+}
+
+func BenchmarkAtomicOptional(b *testing.B) {
+	b.Run("Load", func(b *testing.B) {
+		a := NewAtomicOptional(Some(42))
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = a.Load()
+			}
+		})
+	})
+
+	b.Run("Store", func(b *testing.B) {
+		var a AtomicOptional[int]
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				a.Store(i)
+				i++
+			}
+		})
+	})
+
+	b.Run("Mixed", func(b *testing.B) {
+		var a AtomicOptional[int]
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				if i%10 == 0 {
+					a.Store(i)
+				} else {
+					_ = a.Load()
+				}
+				i++
+			}
+		})
+	})
+}
@@ -0,0 +1,117 @@
+package optional
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Presence bytes that start every binary and gob encoding of an Optional.
+// They are part of the wire format and must not change.
+const (
+	binaryNone byte = 0x00
+	binarySome byte = 0x01
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+//
+// The encoding is a single presence byte, 0x00 for None and 0x01 for Some,
+// followed for Some by the value's own MarshalBinary output when T implements
+// both encoding.BinaryMarshaler and encoding.BinaryUnmarshaler, or by its gob
+// encoding otherwise. A pointer T is encoded as the value it points to, so
+// Optional[*V] and Optional[V] share an encoding; a nil pointer is an error.
+// None is always the single byte 0x00. The format is stable across versions
+// of this package.
+func (o Optional[T]) MarshalBinary() ([]byte, error) {
+	if !o.present {
+		return []byte{binaryNone}, nil
+	}
+
+	v := reflect.ValueOf(&o.value).Elem()
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, fmt.Errorf("optional: MarshalBinary: nil %s", reflect.TypeFor[T]())
+		}
+		v = v.Elem()
+	}
+
+	if usesBinaryMarshaler(v.Type()) {
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		data, err := ptr.Interface().(encoding.BinaryMarshaler).MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{binarySome}, data...), nil
+	}
+
+	buf := bytes.NewBuffer([]byte{binarySome})
+	if err := gob.NewEncoder(buf).Encode(v.Interface()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+// It accepts the format written by MarshalBinary.
+func (o *Optional[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("optional: UnmarshalBinary: no data")
+	}
+
+	switch data[0] {
+	case binaryNone:
+		if len(data) != 1 {
+			return errors.New("optional: UnmarshalBinary: unexpected data after None")
+		}
+		*o = None[T]()
+		return nil
+	case binarySome:
+	default:
+		return fmt.Errorf("optional: UnmarshalBinary: invalid presence byte 0x%02x", data[0])
+	}
+
+	var value T
+	v := reflect.ValueOf(&value).Elem()
+	for v.Kind() == reflect.Pointer {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+
+	if usesBinaryMarshaler(v.Type()) {
+		if err := v.Addr().Interface().(encoding.BinaryUnmarshaler).UnmarshalBinary(data[1:]); err != nil {
+			return err
+		}
+	} else if err := gob.NewDecoder(bytes.NewReader(data[1:])).Decode(v.Addr().Interface()); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+var (
+	binaryMarshalerType   = reflect.TypeFor[encoding.BinaryMarshaler]()
+	binaryUnmarshalerType = reflect.TypeFor[encoding.BinaryUnmarshaler]()
+)
+
+// usesBinaryMarshaler reports whether values of t are encoded with their own
+// MarshalBinary method. Both directions must be supported so that encoding and
+// decoding always agree on the codec.
+func usesBinaryMarshaler(t reflect.Type) bool {
+	pt := reflect.PointerTo(t)
+	return pt.Implements(binaryMarshalerType) && pt.Implements(binaryUnmarshalerType)
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary format.
+func (o Optional[T]) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the MarshalBinary format.
+func (o *Optional[T]) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
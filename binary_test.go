@@ -0,0 +1,210 @@
+package optional
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+)
+
+type gobRecord struct {
+	Name    string
+	Age     Optional[int]
+	Email   Optional[string]
+	Created Optional[time.Time]
+	Tags    Optional[[]string]
+}
+
+func TestMarshalBinary(t *testing.T) {
+	t.Run("Roundtrip Some", func(t *testing.T) {
+		data, err := Some(42).MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary error: %v", err)
+		}
+		var opt Optional[int]
+		if err := opt.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary error: %v", err)
+		}
+		if opt.OrElse(0) != 42 {
+			t.Errorf("Expected Some(42), got %v", opt)
+		}
+	})
+
+	t.Run("Roundtrip Some zero value", func(t *testing.T) {
+		data, err := Some("").MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary error: %v", err)
+		}
+		opt := None[string]()
+		if err := opt.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary error: %v", err)
+		}
+		if !opt.IsPresent() || opt.Get() != "" {
+			t.Errorf("Expected Some(), got %v", opt)
+		}
+	})
+
+	t.Run("Roundtrip None", func(t *testing.T) {
+		data, err := None[int]().MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary error: %v", err)
+		}
+		opt := Some(1)
+		if err := opt.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary error: %v", err)
+		}
+		if opt.IsPresent() {
+			t.Errorf("Expected None, got %v", opt)
+		}
+	})
+
+	t.Run("Uses BinaryMarshaler of value", func(t *testing.T) {
+		ts := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+		want, _ := ts.MarshalBinary()
+		data, err := Some(ts).MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary error: %v", err)
+		}
+		if !bytes.Equal(data[1:], want) {
+			t.Errorf("Expected value bytes %x, got %x", want, data[1:])
+		}
+		var opt Optional[time.Time]
+		if err := opt.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary error: %v", err)
+		}
+		if !opt.OrElse(time.Time{}).Equal(ts) {
+			t.Errorf("Expected Some(%v), got %v", ts, opt)
+		}
+	})
+
+	t.Run("Roundtrip pointer", func(t *testing.T) {
+		ts := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+		data, err := Some(&ts).MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary error: %v", err)
+		}
+		plain, _ := Some(ts).MarshalBinary()
+		if !bytes.Equal(data, plain) {
+			t.Errorf("Expected pointer to encode like its value: %x, got %x", plain, data)
+		}
+		var opt Optional[*time.Time]
+		if err := opt.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary error: %v", err)
+		}
+		if got := opt.OrElse(nil); got == nil || !got.Equal(ts) {
+			t.Errorf("Expected Some(%v), got %v", ts, opt)
+		}
+
+		n := 42
+		data, err = Some(&n).MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary error: %v", err)
+		}
+		var num Optional[*int]
+		if err := num.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary error: %v", err)
+		}
+		if got := num.OrElse(nil); got == nil || *got != 42 {
+			t.Errorf("Expected Some(42), got %v", num)
+		}
+	})
+
+	t.Run("Nil pointer", func(t *testing.T) {
+		if _, err := Some[*time.Time](nil).MarshalBinary(); err == nil {
+			t.Error("Should return error for nil pointer")
+		}
+		if _, err := Some[*int](nil).MarshalBinary(); err == nil {
+			t.Error("Should return error for nil pointer")
+		}
+	})
+
+	t.Run("Invalid data", func(t *testing.T) {
+		var opt Optional[int]
+		for _, data := range [][]byte{nil, {0x02}, {0x00, 0x01}, {0x01}, {0x01, 0xff}} {
+			if err := opt.UnmarshalBinary(data); err == nil {
+				t.Errorf("Expected error for %x", data)
+			}
+		}
+	})
+}
+
+// TestBinaryWireFormat pins the encoding so that data written by one version
+// can be read by another. Changing these bytes is a breaking change.
+func TestBinaryWireFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  interface{ MarshalBinary() ([]byte, error) }
+		want []byte
+	}{
+		{"None", None[int](), []byte{0x00}},
+		{"Some int", Some(42), []byte{0x01, 0x03, 0x04, 0x00, 0x54}},
+		{"Some string", Some("hi"), []byte{0x01, 0x05, 0x0c, 0x00, 0x02, 'h', 'i'}},
+		{"Some bool", Some(true), []byte{0x01, 0x03, 0x02, 0x00, 0x01}},
+		{"Some time", Some(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+			[]byte{0x01, 0x01, 0x00, 0x00, 0x00, 0x0e, 0xdd, 0x25, 0x74, 0x25, 0x00, 0x00, 0x00, 0x00, 0xff, 0xff}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.opt.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary error: %v", err)
+			}
+			if !bytes.Equal(data, tt.want) {
+				t.Errorf("Wire format changed: expected %#v, got %#v", tt.want, data)
+			}
+		})
+	}
+}
+
+func TestGob(t *testing.T) {
+	t.Run("Roundtrip struct", func(t *testing.T) {
+		ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		original := gobRecord{
+			Name:    "bob",
+			Age:     Some(0),
+			Created: Some(ts),
+			Tags:    Some([]string{"a", "b"}),
+		}
+
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(original); err != nil {
+			t.Fatalf("Encode error: %v", err)
+		}
+		var restored gobRecord
+		if err := gob.NewDecoder(&buf).Decode(&restored); err != nil {
+			t.Fatalf("Decode error: %v", err)
+		}
+
+		if restored.Name != "bob" || !Equal(restored.Age, Some(0)) || restored.Email.IsPresent() {
+			t.Errorf("Unexpected result: %+v", restored)
+		}
+		if !restored.Created.OrElse(time.Time{}).Equal(ts) {
+			t.Errorf("Expected Some(%v), got %v", ts, restored.Created)
+		}
+		if !restored.Tags.Equals(original.Tags) {
+			t.Errorf("Expected %v, got %v", original.Tags, restored.Tags)
+		}
+	})
+
+	t.Run("Stream of Optionals", func(t *testing.T) {
+		var buf bytes.Buffer
+		enc := gob.NewEncoder(&buf)
+		for _, opt := range []Optional[int]{Some(1), None[int](), Some(3)} {
+			if err := enc.Encode(opt); err != nil {
+				t.Fatalf("Encode error: %v", err)
+			}
+		}
+		dec := gob.NewDecoder(&buf)
+		var got []Optional[int]
+		for range 3 {
+			var opt Optional[int]
+			if err := dec.Decode(&opt); err != nil {
+				t.Fatalf("Decode error: %v", err)
+			}
+			got = append(got, opt)
+		}
+		if !Equal(got[0], Some(1)) || got[1].IsPresent() || !Equal(got[2], Some(3)) {
+			t.Errorf("Unexpected result: %v", got)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package optional
+
+import (
+	"context"
+	"time"
+)
+
+// newTimer starts a timer for d and returns its channel and stop function.
+// Tests replace it with a fake clock.
+var newTimer = func(d time.Duration) (<-chan time.Time, func() bool) {
+	t := time.NewTimer(d)
+	return t.C, t.Stop
+}
+
+// TryRecv receives from ch without blocking.
+// Returns None if no value is ready or ch is closed.
+func TryRecv[T any](ch <-chan T) Optional[T] {
+	select {
+	case v, ok := <-ch:
+		return FromOk(v, ok)
+	default:
+		return None[T]()
+	}
+}
+
+// RecvTimeout receives from ch, waiting at most d.
+// Returns None if the timeout expires first or ch is closed.
+func RecvTimeout[T any](ch <-chan T, d time.Duration) Optional[T] {
+	timeout, stop := newTimer(d)
+	defer stop()
+
+	select {
+	case v, ok := <-ch:
+		return FromOk(v, ok)
+	case <-timeout:
+		return None[T]()
+	}
+}
+
+// RecvContext receives from ch, waiting until ctx is done.
+// Returns None if ctx is done first or ch is closed.
+func RecvContext[T any](ctx context.Context, ch <-chan T) Optional[T] {
+	select {
+	case v, ok := <-ch:
+		return FromOk(v, ok)
+	case <-ctx.Done():
+		return None[T]()
+	}
+}
+
+// Collect receives every value from ch until it is closed or ctx is done.
+// It returns the values received so far together with nil if ch was closed,
+// or with ctx.Err() if ctx finished first, so callers can tell a complete
+// stream from one cut short by a timeout or cancellation.
+func Collect[T any](ctx context.Context, ch <-chan T) ([]T, error) {
+	var values []T
+	for {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return values, nil
+			}
+			values = append(values, v)
+		case <-ctx.Done():
+			return values, ctx.Err()
+		}
+	}
+}
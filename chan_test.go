@@ -0,0 +1,177 @@
+package optional
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeClock replaces newTimer for the duration of a test.
+// Timers only fire when Advance moves the clock past their deadline.
+type fakeClock struct {
+	now    time.Duration
+	timers []fakeTimer
+	armed  chan struct{}
+}
+
+type fakeTimer struct {
+	deadline time.Duration
+	ch       chan time.Time
+}
+
+func installFakeClock(t *testing.T) *fakeClock {
+	c := &fakeClock{armed: make(chan struct{}, 16)}
+	old := newTimer
+	newTimer = func(d time.Duration) (<-chan time.Time, func() bool) {
+		ch := make(chan time.Time, 1)
+		c.timers = append(c.timers, fakeTimer{deadline: c.now + d, ch: ch})
+		c.armed <- struct{}{}
+		return ch, func() bool { return true }
+	}
+	t.Cleanup(func() { newTimer = old })
+	return c
+}
+
+// Advance moves the clock forward and fires the timers that are due.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now += d
+	for _, timer := range c.timers {
+		if timer.deadline <= c.now {
+			select {
+			case timer.ch <- time.Time{}:
+			default:
+			}
+		}
+	}
+}
+
+func TestTryRecv(t *testing.T) {
+	ch := make(chan int, 1)
+	if opt := TryRecv(ch); opt.IsPresent() {
+		t.Errorf("Expected None on empty channel, got %v", opt)
+	}
+	ch <- 0
+	if opt := TryRecv(ch); !Equal(opt, Some(0)) {
+		t.Errorf("Expected Some(0), got %v", opt)
+	}
+	close(ch)
+	if opt := TryRecv(ch); opt.IsPresent() {
+		t.Errorf("Expected None on closed channel, got %v", opt)
+	}
+}
+
+func TestRecvTimeout(t *testing.T) {
+	t.Run("Value before timeout", func(t *testing.T) {
+		installFakeClock(t)
+		ch := make(chan string, 1)
+		ch <- "job"
+		if opt := RecvTimeout(ch, time.Second); !Equal(opt, Some("job")) {
+			t.Errorf("Expected Some(job), got %v", opt)
+		}
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		clock := installFakeClock(t)
+		ch := make(chan string)
+		result := make(chan Optional[string])
+		go func() { result <- RecvTimeout(ch, time.Second) }()
+
+		<-clock.armed
+		clock.Advance(999 * time.Millisecond)
+		select {
+		case opt := <-result:
+			t.Fatalf("Returned %v before the deadline", opt)
+		default:
+		}
+		clock.Advance(time.Millisecond)
+		if opt := <-result; opt.IsPresent() {
+			t.Errorf("Expected None after timeout, got %v", opt)
+		}
+	})
+
+	t.Run("Closed channel", func(t *testing.T) {
+		installFakeClock(t)
+		ch := make(chan string)
+		close(ch)
+		if opt := RecvTimeout(ch, time.Hour); opt.IsPresent() {
+			t.Errorf("Expected None on closed channel, got %v", opt)
+		}
+	})
+
+	t.Run("Real timer", func(t *testing.T) {
+		if opt := RecvTimeout(make(chan int), time.Millisecond); opt.IsPresent() {
+			t.Errorf("Expected None after timeout, got %v", opt)
+		}
+	})
+}
+
+func TestRecvContext(t *testing.T) {
+	t.Run("Value", func(t *testing.T) {
+		ch := make(chan int, 1)
+		ch <- 5
+		if opt := RecvContext(context.Background(), ch); !Equal(opt, Some(5)) {
+			t.Errorf("Expected Some(5), got %v", opt)
+		}
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if opt := RecvContext(ctx, make(chan int)); opt.IsPresent() {
+			t.Errorf("Expected None after cancel, got %v", opt)
+		}
+	})
+
+	t.Run("Closed channel", func(t *testing.T) {
+		ch := make(chan int)
+		close(ch)
+		if opt := RecvContext(context.Background(), ch); opt.IsPresent() {
+			t.Errorf("Expected None on closed channel, got %v", opt)
+		}
+	})
+}
+
+func TestCollect(t *testing.T) {
+	t.Run("Closed channel", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		close(ch)
+		values, err := Collect(context.Background(), ch)
+		if err != nil {
+			t.Errorf("Expected nil error for closed channel, got %v", err)
+		}
+		if !slices.Equal(values, []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3], got %v", values)
+		}
+	})
+
+	t.Run("Cancelled keeps partial values", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := make(chan int)
+		go func() {
+			ch <- 1
+			ch <- 2
+			cancel()
+		}()
+		values, err := Collect(ctx, ch)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if !slices.Equal(values, []int{1, 2}) {
+			t.Errorf("Expected [1 2], got %v", values)
+		}
+	})
+
+	t.Run("Deadline", func(t *testing.T) {
+		ctx, cancel := context.WithDeadline(context.Background(), time.Unix(0, 0))
+		defer cancel()
+		values, err := Collect(ctx, make(chan int))
+		if !errors.Is(err, context.DeadlineExceeded) || values != nil {
+			t.Errorf("Expected (nil, DeadlineExceeded), got (%v, %v)", values, err)
+		}
+	})
+}
@@ -0,0 +1,462 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// optionalPath is the import path of the package that defines Optional.
+const optionalPath = "github.com/vuongnq9x/optional"
+
+// ignoreDirective suppresses a finding on its own line or the line below.
+const ignoreDirective = "//optional:ignore"
+
+// Diagnostic is a single finding.
+type Diagnostic struct {
+	Pos     token.Position
+	Message string
+}
+
+// String returns the diagnostic in file:line:col: message form
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s", d.Pos, d.Message)
+}
+
+// checkDir parses and type-checks the package in dir, including its tests,
+// and returns the findings sorted by position.
+func checkDir(dir string) ([]Diagnostic, error) {
+	pkg, err := build.ImportDir(dir, build.ImportComment)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	imp := importer.ForCompiler(fset, "source", nil)
+
+	var diags []Diagnostic
+	groups := [][]string{append(pkg.GoFiles, pkg.TestGoFiles...), pkg.XTestGoFiles}
+	for _, names := range groups {
+		if len(names) == 0 {
+			continue
+		}
+		var files []*ast.File
+		for _, name := range names {
+			f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, f)
+		}
+		d, err := checkFiles(fset, imp, files)
+		if err != nil {
+			return nil, err
+		}
+		diags = append(diags, d...)
+	}
+	slices.SortFunc(diags, func(a, b Diagnostic) int {
+		return cmp.Or(strings.Compare(a.Pos.Filename, b.Pos.Filename), cmp.Compare(a.Pos.Offset, b.Pos.Offset))
+	})
+	return diags, nil
+}
+
+// checkFiles type-checks files as one package and reports unchecked Get calls.
+func checkFiles(fset *token.FileSet, imp types.Importer, files []*ast.File) ([]Diagnostic, error) {
+	info := &types.Info{Types: make(map[ast.Expr]types.TypeAndValue)}
+	conf := types.Config{Importer: imp}
+	if _, err := conf.Check(files[0].Name.Name, fset, files, info); err != nil {
+		return nil, err
+	}
+
+	var diags []Diagnostic
+	for _, f := range files {
+		c := &checker{fset: fset, info: info, ignored: ignoredLines(fset, f)}
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+				c.block(fn.Body.List, facts{})
+			}
+		}
+		diags = append(diags, c.diags...)
+	}
+	return diags, nil
+}
+
+// ignoredLines returns the lines covered by an ignore directive in f.
+func ignoredLines(fset *token.FileSet, f *ast.File) map[int]bool {
+	lines := make(map[int]bool)
+	for _, group := range f.Comments {
+		for _, comment := range group.List {
+			if strings.HasPrefix(comment.Text, ignoreDirective) {
+				line := fset.Position(comment.Slash).Line
+				lines[line] = true
+				lines[line+1] = true
+			}
+		}
+	}
+	return lines
+}
+
+// facts is the set of Optional expressions known to be present,
+// keyed by their source text.
+type facts map[string]bool
+
+func (f facts) with(other facts) facts {
+	if len(other) == 0 {
+		return f
+	}
+	merged := make(facts, len(f)+len(other))
+	for k := range f {
+		merged[k] = true
+	}
+	for k := range other {
+		merged[k] = true
+	}
+	return merged
+}
+
+func (f facts) intersect(other facts) facts {
+	result := facts{}
+	for k := range f {
+		if other[k] {
+			result[k] = true
+		}
+	}
+	return result
+}
+
+// withoutAll returns the facts that mention none of the assigned expressions.
+func (f facts) withoutAll(assigned []string) facts {
+	for _, a := range assigned {
+		f = f.without(a)
+	}
+	return f
+}
+
+// without returns the facts that do not mention the assigned expression.
+func (f facts) without(assigned string) facts {
+	result := make(facts, len(f))
+	for k := range f {
+		if k != assigned && !strings.HasPrefix(k, assigned+".") {
+			result[k] = true
+		}
+	}
+	return result
+}
+
+type checker struct {
+	fset    *token.FileSet
+	info    *types.Info
+	ignored map[int]bool
+	diags   []Diagnostic
+}
+
+// block checks a statement list in order, carrying facts learned from
+// early-exit guards into the statements that follow them.
+func (c *checker) block(stmts []ast.Stmt, known facts) {
+	for _, s := range stmts {
+		known = c.stmt(s, known)
+	}
+}
+
+// stmt checks a statement and returns the facts that hold after it.
+func (c *checker) stmt(s ast.Stmt, known facts) facts {
+	switch s := s.(type) {
+	case *ast.IfStmt:
+		return c.ifStmt(s, known)
+	case *ast.LabeledStmt:
+		return c.stmt(s.Stmt, known)
+	case *ast.BlockStmt:
+		c.block(s.List, known)
+	case *ast.ForStmt:
+		if s.Init != nil {
+			known = c.stmt(s.Init, known)
+		}
+		// Facts that any iteration can invalidate do not hold at the top of the body.
+		loopKnown := known.withoutAll(c.assigned(s.Body)).withoutAll(c.assigned(s.Post))
+		var pos facts
+		if s.Cond != nil {
+			c.expr(s.Cond, loopKnown)
+			pos, _ = c.cond(s.Cond)
+		}
+		if s.Post != nil {
+			c.stmt(s.Post, loopKnown)
+		}
+		c.block(s.Body.List, loopKnown.with(pos))
+	case *ast.RangeStmt:
+		c.expr(s.X, known)
+		c.block(s.Body.List, known.withoutAll(c.assigned(s.Body)))
+	case *ast.SwitchStmt:
+		if s.Init != nil {
+			known = c.stmt(s.Init, known)
+		}
+		if s.Tag != nil {
+			c.expr(s.Tag, known)
+		}
+		for _, clause := range s.Body.List {
+			cc := clause.(*ast.CaseClause)
+			caseKnown := known
+			for _, e := range cc.List {
+				c.expr(e, known)
+				if s.Tag == nil && len(cc.List) == 1 {
+					pos, _ := c.cond(e)
+					caseKnown = known.with(pos)
+				}
+			}
+			c.block(cc.Body, caseKnown)
+		}
+	case *ast.TypeSwitchStmt:
+		if s.Init != nil {
+			known = c.stmt(s.Init, known)
+		}
+		c.stmt(s.Assign, known)
+		for _, clause := range s.Body.List {
+			c.block(clause.(*ast.CaseClause).Body, known)
+		}
+	case *ast.SelectStmt:
+		for _, clause := range s.Body.List {
+			cc := clause.(*ast.CommClause)
+			if cc.Comm != nil {
+				c.stmt(cc.Comm, known)
+			}
+			c.block(cc.Body, known)
+		}
+	case *ast.AssignStmt:
+		for _, e := range s.Rhs {
+			c.expr(e, known)
+		}
+		for _, e := range s.Lhs {
+			c.expr(e, known)
+		}
+	default:
+		c.expr(s, known)
+	}
+	return known.withoutAll(c.assigned(s))
+}
+
+// ifStmt checks an if statement and returns the facts that hold after it.
+// Assignments in a branch that cannot fall through do not affect what follows.
+func (c *checker) ifStmt(s *ast.IfStmt, known facts) facts {
+	if s.Init != nil {
+		known = c.stmt(s.Init, known)
+	}
+	c.expr(s.Cond, known)
+	known = known.withoutAll(c.assigned(s.Cond))
+	pos, neg := c.cond(s.Cond)
+	c.block(s.Body.List, known.with(pos))
+	if s.Else != nil {
+		c.stmt(s.Else, known.with(neg))
+	}
+
+	after := known
+	switch {
+	case terminates(s.Body):
+		after = known.with(neg)
+	case s.Else != nil && terminates(s.Else):
+		after = known.with(pos)
+	}
+	if !terminates(s.Body) {
+		after = after.withoutAll(c.assigned(s.Body))
+	}
+	if s.Else != nil && !terminates(s.Else) {
+		after = after.withoutAll(c.assigned(s.Else))
+	}
+	return after
+}
+
+// assigned returns the source text of every expression that n may modify:
+// assignment and increment targets, range variables, operands of &, and
+// receivers of pointer-receiver method calls on an Optional, such as Take or Scan.
+func (c *checker) assigned(n ast.Node) []string {
+	if n == nil {
+		return nil
+	}
+	var exprs []string
+	add := func(e ast.Expr) {
+		if e == nil {
+			return
+		}
+		if id, ok := e.(*ast.Ident); ok && id.Name == "_" {
+			return
+		}
+		exprs = append(exprs, types.ExprString(e))
+	}
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, e := range n.Lhs {
+				add(e)
+			}
+		case *ast.IncDecStmt:
+			add(n.X)
+		case *ast.RangeStmt:
+			add(n.Key)
+			add(n.Value)
+		case *ast.UnaryExpr:
+			if n.Op == token.AND {
+				add(n.X)
+			}
+		case *ast.CallExpr:
+			if recv, ok := c.mutatingCall(n); ok {
+				add(recv)
+			}
+		}
+		return true
+	})
+	return exprs
+}
+
+// mutatingCall reports whether call is a call of a pointer-receiver method
+// on an optional.Optional and returns the receiver.
+func (c *checker) mutatingCall(call *ast.CallExpr) (ast.Expr, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, false
+	}
+	recv := c.info.TypeOf(sel.X)
+	if !isOptional(recv) {
+		return nil, false
+	}
+	obj, _, _ := types.LookupFieldOrMethod(recv, true, nil, sel.Sel.Name)
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return nil, false
+	}
+	_, isPtr := fn.Type().(*types.Signature).Recv().Type().(*types.Pointer)
+	return sel.X, isPtr
+}
+
+// expr reports unchecked Get calls within n.
+func (c *checker) expr(n ast.Node, known facts) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// A function literal may run at any time, so it starts without facts.
+			c.block(n.Body.List, facts{})
+			return false
+		case *ast.BinaryExpr:
+			switch n.Op {
+			case token.LAND:
+				c.expr(n.X, known)
+				pos, _ := c.cond(n.X)
+				c.expr(n.Y, known.with(pos))
+				return false
+			case token.LOR:
+				c.expr(n.X, known)
+				_, neg := c.cond(n.X)
+				c.expr(n.Y, known.with(neg))
+				return false
+			}
+		case *ast.CallExpr:
+			if recv, ok := c.optionalCall(n, "Get"); ok && !known[recv] {
+				c.report(n)
+			}
+		}
+		return true
+	})
+}
+
+// cond returns the Optionals known to be present when e is true (pos)
+// and when e is false (neg).
+func (c *checker) cond(e ast.Expr) (pos, neg facts) {
+	switch e := e.(type) {
+	case *ast.ParenExpr:
+		return c.cond(e.X)
+	case *ast.UnaryExpr:
+		if e.Op == token.NOT {
+			pos, neg = c.cond(e.X)
+			return neg, pos
+		}
+	case *ast.BinaryExpr:
+		xPos, xNeg := c.cond(e.X)
+		yPos, yNeg := c.cond(e.Y)
+		switch e.Op {
+		case token.LAND:
+			return xPos.with(yPos), xNeg.intersect(yNeg)
+		case token.LOR:
+			return xPos.intersect(yPos), xNeg.with(yNeg)
+		}
+	case *ast.CallExpr:
+		if recv, ok := c.optionalCall(e, "IsPresent"); ok {
+			return facts{recv: true}, nil
+		}
+		if recv, ok := c.optionalCall(e, "IsEmpty"); ok {
+			return nil, facts{recv: true}
+		}
+	}
+	return nil, nil
+}
+
+// optionalCall reports whether call is a call of the named method on an
+// optional.Optional value and returns the receiver's source text.
+func (c *checker) optionalCall(call *ast.CallExpr, method string) (string, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != method || len(call.Args) != 0 {
+		return "", false
+	}
+	if !isOptional(c.info.TypeOf(sel.X)) {
+		return "", false
+	}
+	return types.ExprString(sel.X), true
+}
+
+func (c *checker) report(call *ast.CallExpr) {
+	pos := c.fset.Position(call.Pos())
+	if c.ignored[pos.Line] {
+		return
+	}
+	c.diags = append(c.diags, Diagnostic{
+		Pos:     pos,
+		Message: "call to Get on optional.Optional is not guarded by IsPresent or IsEmpty",
+	})
+}
+
+// isOptional reports whether t is optional.Optional[T] or a pointer to one.
+func isOptional(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == optionalPath && obj.Name() == "Optional"
+}
+
+// terminates reports whether control never falls off the end of s.
+func terminates(s ast.Stmt) bool {
+	switch s := s.(type) {
+	case *ast.BlockStmt:
+		return len(s.List) > 0 && terminates(s.List[len(s.List)-1])
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.IfStmt:
+		return s.Else != nil && terminates(s.Body) && terminates(s.Else)
+	case *ast.ExprStmt:
+		call, ok := s.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			return fun.Name == "panic"
+		case *ast.SelectorExpr:
+			switch fun.Sel.Name {
+			case "Exit", "Fatal", "Fatalf", "Fatalln", "FailNow", "Panic", "Panicf", "Panicln", "Skip", "Skipf", "SkipNow":
+				return true
+			}
+		}
+	}
+	return false
+}
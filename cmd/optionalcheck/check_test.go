@@ -0,0 +1,108 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestGolden(t *testing.T) {
+	dirs, err := filepath.Glob(filepath.Join("testdata", "src", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) == 0 {
+		t.Fatal("no testdata packages found")
+	}
+
+	for _, dir := range dirs {
+		name := filepath.Base(dir)
+		t.Run(name, func(t *testing.T) {
+			diags, err := checkDir(dir)
+			if err != nil {
+				t.Fatalf("checkDir error: %v", err)
+			}
+
+			var b strings.Builder
+			for _, d := range diags {
+				d.Pos.Filename = filepath.Base(d.Pos.Filename)
+				b.WriteString(d.String())
+				b.WriteByte('\n')
+			}
+			got := b.String()
+
+			golden := filepath.Join(dir, name+".golden")
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("reading golden file: %v", err)
+			}
+			if got != string(want) {
+				t.Errorf("diagnostics mismatch\ngot:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestWantMarkers(t *testing.T) {
+	dirs, err := filepath.Glob(filepath.Join("testdata", "src", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dir := range dirs {
+		diags, err := checkDir(dir)
+		if err != nil {
+			t.Fatalf("checkDir(%s) error: %v", dir, err)
+		}
+		reported := make(map[string]bool)
+		for _, d := range diags {
+			reported[d.Pos.Filename+":"+strconv.Itoa(d.Pos.Line)] = true
+		}
+
+		files, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, line := range strings.Split(string(data), "\n") {
+				key := file + ":" + strconv.Itoa(i+1)
+				want := strings.Contains(line, "// want")
+				if want != reported[key] {
+					t.Errorf("%s: want diagnostic %v, reported %v", key, want, reported[key])
+				}
+			}
+		}
+	}
+}
+
+func TestExpandDirs(t *testing.T) {
+	dirs, err := expandDirs([]string{"testdata/src/..."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) != 3 {
+		t.Errorf("Expected 3 package directories, got %v", dirs)
+	}
+
+	dirs, err = expandDirs([]string{"./..."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range dirs {
+		if strings.Contains(dir, "testdata") {
+			t.Errorf("testdata should be skipped, got %s", dir)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Command optionalcheck reports calls to optional.Optional.Get that are not
+// guarded by an IsPresent or IsEmpty check in the same function.
+//
+// Usage:
+//
+//	optionalcheck [packages]
+//
+// Each argument is a package directory; a trailing "/..." also checks every
+// package below it. Findings are printed one per line in the form
+// file:line:col: message, like go vet. A call can be suppressed by putting an
+// //optional:ignore comment on the same line or on the line above it.
+//
+// The exit status is 0 when nothing is found, 3 when findings were reported
+// and 1 when a package could not be loaded.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: optionalcheck [packages]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 {
+		args = []string{"."}
+	}
+
+	dirs, err := expandDirs(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "optionalcheck: %v\n", err)
+		os.Exit(1)
+	}
+
+	exitCode := 0
+	for _, dir := range dirs {
+		diags, err := checkDir(dir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "optionalcheck: %v\n", err)
+			exitCode = 1
+			continue
+		}
+		for _, d := range diags {
+			fmt.Println(d)
+		}
+		if len(diags) > 0 && exitCode == 0 {
+			exitCode = 3
+		}
+	}
+	os.Exit(exitCode)
+}
+
+// expandDirs resolves the command-line arguments into package directories.
+func expandDirs(args []string) ([]string, error) {
+	var dirs []string
+	for _, arg := range args {
+		root, ok := strings.CutSuffix(arg, "/...")
+		if !ok {
+			dirs = append(dirs, arg)
+			continue
+		}
+		if root == "" {
+			root = "."
+		}
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() {
+				return nil
+			}
+			name := d.Name()
+			if path != root && (name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			if hasGoFiles(path) {
+				dirs = append(dirs, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return dirs, nil
+}
+
+func hasGoFiles(dir string) bool {
+	matches, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	return len(matches) > 0
+}
@@ -0,0 +1,119 @@
+package flow
+
+import (
+	"errors"
+
+	"github.com/vuongnq9x/optional"
+)
+
+func earlyReturn(opt optional.Optional[string]) string {
+	if opt.IsEmpty() {
+		return ""
+	}
+	return opt.Get()
+}
+
+func earlyPanic(opt optional.Optional[string]) string {
+	if !opt.IsPresent() {
+		panic("missing")
+	}
+	return opt.Get()
+}
+
+func noExit(opt optional.Optional[string]) string {
+	if opt.IsEmpty() {
+		println("missing")
+	}
+	return opt.Get() // want
+}
+
+func loop(opts []optional.Optional[int]) int {
+	sum := 0
+	for _, opt := range opts {
+		if opt.IsEmpty() {
+			continue
+		}
+		sum += opt.Get()
+	}
+	return sum
+}
+
+func reassigned(opt optional.Optional[int]) int {
+	if opt.IsEmpty() {
+		return 0
+	}
+	opt = optional.None[int]()
+	return opt.Get() // want
+}
+
+func closure(opt optional.Optional[int]) func() int {
+	if opt.IsPresent() {
+		return func() int {
+			return opt.Get() // want
+		}
+	}
+	return nil
+}
+
+func switchCase(opt optional.Optional[int]) (int, error) {
+	switch {
+	case opt.IsPresent():
+		return opt.Get(), nil
+	default:
+		return opt.Get(), errors.New("missing") // want
+	}
+}
+
+func reassignedInBranch(opt optional.Optional[int], reset bool) int {
+	if !opt.IsEmpty() {
+		if reset {
+			opt = optional.None[int]()
+		}
+		return opt.Get() // want
+	}
+	return 0
+}
+
+func reassignedInLoop(opt optional.Optional[int]) int {
+	if opt.IsEmpty() {
+		return 0
+	}
+	sum := 0
+	for range 3 {
+		sum += opt.Get() // want
+		opt = optional.None[int]()
+	}
+	return sum
+}
+
+func taken(opt optional.Optional[int]) int {
+	if opt.IsEmpty() {
+		return 0
+	}
+	opt.Take()
+	return opt.Get() // want
+}
+
+func addressTaken(opt optional.Optional[int], reset func(*optional.Optional[int])) int {
+	if opt.IsEmpty() {
+		return 0
+	}
+	reset(&opt)
+	return opt.Get() // want
+}
+
+func reassignedBeforeReturn(opt optional.Optional[int]) int {
+	if opt.IsEmpty() {
+		opt = optional.Some(0)
+		return 0
+	}
+	return opt.Get()
+}
+
+func unrelatedMethod(opt optional.Optional[int]) int {
+	if opt.IsEmpty() {
+		return 0
+	}
+	opt.IfPresent(func(int) {})
+	return opt.Get()
+}
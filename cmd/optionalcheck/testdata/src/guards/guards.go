@@ -0,0 +1,83 @@
+package guards
+
+import "github.com/vuongnq9x/optional"
+
+type config struct {
+	Port optional.Optional[int]
+}
+
+func unguarded(opt optional.Optional[int]) int {
+	return opt.Get() // want
+}
+
+func ifPresent(opt optional.Optional[int]) int {
+	if opt.IsPresent() {
+		return opt.Get()
+	}
+	return 0
+}
+
+func notEmpty(opt optional.Optional[int]) int {
+	if !opt.IsEmpty() {
+		return opt.Get()
+	}
+	return 0
+}
+
+func elseOfEmpty(opt optional.Optional[int]) int {
+	if opt.IsEmpty() {
+		return 0
+	} else {
+		return opt.Get()
+	}
+}
+
+func wrongBranch(opt optional.Optional[int]) int {
+	if opt.IsPresent() {
+		return 0
+	}
+	return opt.Get() // want
+}
+
+func conjunction(a, b optional.Optional[int]) int {
+	if a.IsPresent() && b.IsPresent() {
+		return a.Get() + b.Get()
+	}
+	return 0
+}
+
+func disjunction(a, b optional.Optional[int]) int {
+	if a.IsPresent() || b.IsPresent() {
+		return a.Get() // want
+	}
+	return 0
+}
+
+func shortCircuit(opt optional.Optional[int]) bool {
+	return opt.IsPresent() && opt.Get() > 0
+}
+
+func shortCircuitOr(opt optional.Optional[int]) bool {
+	return opt.IsEmpty() || opt.Get() > 0
+}
+
+func otherOptional(a, b optional.Optional[int]) int {
+	if a.IsPresent() {
+		return b.Get() // want
+	}
+	return 0
+}
+
+func field(c config) int {
+	if c.Port.IsPresent() {
+		return c.Port.Get()
+	}
+	return c.Port.Get() // want
+}
+
+func pointer(opt *optional.Optional[int]) int {
+	if opt.IsPresent() {
+		return opt.Get()
+	}
+	return 0
+}
@@ -0,0 +1,26 @@
+package ignore
+
+import "github.com/vuongnq9x/optional"
+
+func sameLine(opt optional.Optional[int]) int {
+	return opt.Get() //optional:ignore
+}
+
+func lineAbove(opt optional.Optional[int]) int {
+	//optional:ignore caller guarantees presence
+	return opt.Get()
+}
+
+func tooFar(opt optional.Optional[int]) int {
+	//optional:ignore
+
+	return opt.Get() // want
+}
+
+type other struct{}
+
+func (other) Get() int { return 0 }
+
+func notOptional(o other) int {
+	return o.Get()
+}
@@ -0,0 +1,112 @@
+package optional
+
+// And returns other if this Optional has a value, otherwise None.
+func (o Optional[T]) And(other Optional[T]) Optional[T] {
+	if o.present {
+		return other
+	}
+	return None[T]()
+}
+
+// AndThen calls mapper with the value if present and returns its result, otherwise None.
+// It is the method form of FlatMap for mappers that keep the same type.
+func (o Optional[T]) AndThen(mapper func(T) Optional[T]) Optional[T] {
+	if o.present {
+		return mapper(o.value)
+	}
+	return None[T]()
+}
+
+// Xor returns whichever of this Optional and other has a value if exactly one does,
+// otherwise None.
+func (o Optional[T]) Xor(other Optional[T]) Optional[T] {
+	switch {
+	case o.present && !other.present:
+		return o
+	case !o.present && other.present:
+		return other
+	}
+	return None[T]()
+}
+
+// Inspect calls the consumer function with the value if present and returns the Optional unchanged.
+func (o Optional[T]) Inspect(consumer func(T)) Optional[T] {
+	if o.present {
+		consumer(o.value)
+	}
+	return o
+}
+
+// IsSomeAnd returns true if the Optional has a value that satisfies the predicate.
+func (o Optional[T]) IsSomeAnd(predicate func(T) bool) bool {
+	return o.present && predicate(o.value)
+}
+
+// IsNoneOr returns true if the Optional is empty or its value satisfies the predicate.
+func (o Optional[T]) IsNoneOr(predicate func(T) bool) bool {
+	return !o.present || predicate(o.value)
+}
+
+// Take returns the Optional and leaves None in its place.
+func (o *Optional[T]) Take() Optional[T] {
+	old := *o
+	*o = None[T]()
+	return old
+}
+
+// Replace stores the value and returns the previous Optional.
+func (o *Optional[T]) Replace(value T) Optional[T] {
+	old := *o
+	*o = Some(value)
+	return old
+}
+
+// Insert stores the value, discarding any previous one,
+// then returns a pointer to the contained value.
+func (o *Optional[T]) Insert(value T) *T {
+	*o = Some(value)
+	return &o.value
+}
+
+// GetOrInsert stores the value if the Optional is empty,
+// then returns a pointer to the contained value.
+func (o *Optional[T]) GetOrInsert(value T) *T {
+	if !o.present {
+		*o = Some(value)
+	}
+	return &o.value
+}
+
+// GetOrInsertWith stores the result of supplier if the Optional is empty,
+// then returns a pointer to the contained value. The supplier is only called when empty.
+func (o *Optional[T]) GetOrInsertWith(supplier func() T) *T {
+	if !o.present {
+		*o = Some(supplier())
+	}
+	return &o.value
+}
+
+// MapOr returns the result of mapper applied to the value if present, otherwise the default value.
+func MapOr[T, U any](opt Optional[T], defaultValue U, mapper func(T) U) U {
+	if opt.present {
+		return mapper(opt.value)
+	}
+	return defaultValue
+}
+
+// MapOrElse returns the result of mapper applied to the value if present,
+// otherwise the result of the supplier function.
+func MapOrElse[T, U any](opt Optional[T], supplier func() U, mapper func(T) U) U {
+	if opt.present {
+		return mapper(opt.value)
+	}
+	return supplier()
+}
+
+// Flatten removes one level of nesting from an Optional of an Optional.
+func Flatten[T any](opt Optional[Optional[T]]) Optional[T] {
+	if opt.present {
+		return opt.value
+	}
+	return None[T]()
+}
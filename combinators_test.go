@@ -0,0 +1,327 @@
+package optional
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestAnd(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Optional[int]
+		want Optional[int]
+	}{
+		{"Some.And(Some)", Some(1), Some(2), Some(2)},
+		{"Some.And(None)", Some(1), None[int](), None[int]()},
+		{"None.And(Some)", None[int](), Some(2), None[int]()},
+		{"None.And(None)", None[int](), None[int](), None[int]()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.And(tt.b); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAndThen(t *testing.T) {
+	half := func(x int) Optional[int] {
+		if x%2 == 0 {
+			return Some(x / 2)
+		}
+		return None[int]()
+	}
+	if got := Some(8).AndThen(half).AndThen(half); !Equal(got, Some(2)) {
+		t.Errorf("Expected Some(2), got %v", got)
+	}
+	if got := Some(3).AndThen(half); got.IsPresent() {
+		t.Errorf("Expected None, got %v", got)
+	}
+	if got := None[int]().AndThen(half); got.IsPresent() {
+		t.Errorf("Expected None, got %v", got)
+	}
+}
+
+func TestXor(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Optional[int]
+		want Optional[int]
+	}{
+		{"Some.Xor(Some)", Some(1), Some(2), None[int]()},
+		{"Some.Xor(None)", Some(1), None[int](), Some(1)},
+		{"None.Xor(Some)", None[int](), Some(2), Some(2)},
+		{"None.Xor(None)", None[int](), None[int](), None[int]()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Xor(tt.b); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMapOr(t *testing.T) {
+	if got := MapOr(Some(42), "none", strconv.Itoa); got != "42" {
+		t.Errorf("Expected '42', got %q", got)
+	}
+	if got := MapOr(None[int](), "none", strconv.Itoa); got != "none" {
+		t.Errorf("Expected 'none', got %q", got)
+	}
+}
+
+func TestMapOrElse(t *testing.T) {
+	called := false
+	supplier := func() string {
+		called = true
+		return "none"
+	}
+	if got := MapOrElse(Some(42), supplier, strconv.Itoa); got != "42" || called {
+		t.Errorf("Expected '42' without calling supplier, got %q", got)
+	}
+	if got := MapOrElse(None[int](), supplier, strconv.Itoa); got != "none" || !called {
+		t.Errorf("Expected 'none' from supplier, got %q", got)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	var seen []int
+	record := func(x int) { seen = append(seen, x) }
+
+	got := Map(Some(1).Inspect(record), func(x int) int { return x + 1 }).Inspect(record)
+	if !Equal(got, Some(2)) {
+		t.Errorf("Expected Some(2), got %v", got)
+	}
+	None[int]().Inspect(record)
+	if len(seen) != 2 || seen[0] != 1 || seen[1] != 2 {
+		t.Errorf("Expected [1 2], got %v", seen)
+	}
+}
+
+func TestIsSomeAnd(t *testing.T) {
+	positive := func(x int) bool { return x > 0 }
+	if !Some(1).IsSomeAnd(positive) {
+		t.Error("Some(1) should satisfy IsSomeAnd")
+	}
+	if Some(-1).IsSomeAnd(positive) {
+		t.Error("Some(-1) should not satisfy IsSomeAnd")
+	}
+	if None[int]().IsSomeAnd(positive) {
+		t.Error("None should not satisfy IsSomeAnd")
+	}
+}
+
+func TestIsNoneOr(t *testing.T) {
+	positive := func(x int) bool { return x > 0 }
+	if !Some(1).IsNoneOr(positive) {
+		t.Error("Some(1) should satisfy IsNoneOr")
+	}
+	if Some(-1).IsNoneOr(positive) {
+		t.Error("Some(-1) should not satisfy IsNoneOr")
+	}
+	if !None[int]().IsNoneOr(positive) {
+		t.Error("None should satisfy IsNoneOr")
+	}
+}
+
+func TestTake(t *testing.T) {
+	opt := Some(42)
+	if got := opt.Take(); !Equal(got, Some(42)) {
+		t.Errorf("Expected Some(42), got %v", got)
+	}
+	if opt.IsPresent() {
+		t.Errorf("Expected None after Take, got %v", opt)
+	}
+	if got := opt.Take(); got.IsPresent() {
+		t.Errorf("Expected None from empty Take, got %v", got)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	opt := None[string]()
+	if old := opt.Replace("a"); old.IsPresent() {
+		t.Errorf("Expected None, got %v", old)
+	}
+	if old := opt.Replace("b"); !Equal(old, Some("a")) {
+		t.Errorf("Expected Some(a), got %v", old)
+	}
+	if !Equal(opt, Some("b")) {
+		t.Errorf("Expected Some(b), got %v", opt)
+	}
+}
+
+func TestInsert(t *testing.T) {
+	for _, opt := range []Optional[int]{None[int](), Some(1)} {
+		ptr := opt.Insert(5)
+		*ptr++
+		if !Equal(opt, Some(6)) {
+			t.Errorf("Expected Some(6), got %v", opt)
+		}
+	}
+}
+
+func TestGetOrInsert(t *testing.T) {
+	t.Run("Inserts into None", func(t *testing.T) {
+		opt := None[int]()
+		ptr := opt.GetOrInsert(5)
+		*ptr++
+		if !Equal(opt, Some(6)) {
+			t.Errorf("Expected Some(6), got %v", opt)
+		}
+	})
+
+	t.Run("Keeps existing value", func(t *testing.T) {
+		opt := Some(1)
+		if ptr := opt.GetOrInsert(5); *ptr != 1 {
+			t.Errorf("Expected 1, got %v", *ptr)
+		}
+	})
+}
+
+func TestGetOrInsertWith(t *testing.T) {
+	calls := 0
+	supplier := func() []string {
+		calls++
+		return []string{"init"}
+	}
+
+	var opt Optional[[]string]
+	ptr := opt.GetOrInsertWith(supplier)
+	*ptr = append(*ptr, "more")
+	opt.GetOrInsertWith(supplier)
+	if calls != 1 {
+		t.Errorf("Expected supplier to run once, got %d", calls)
+	}
+	if got := opt.Get(); len(got) != 2 || got[1] != "more" {
+		t.Errorf("Expected [init more], got %v", got)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	if got := Flatten(Some(Some(1))); !Equal(got, Some(1)) {
+		t.Errorf("Expected Some(1), got %v", got)
+	}
+	if got := Flatten(Some(None[int]())); got.IsPresent() {
+		t.Errorf("Expected None, got %v", got)
+	}
+	if got := Flatten(None[Optional[int]]()); got.IsPresent() {
+		t.Errorf("Expected None, got %v", got)
+	}
+}
+
+func BenchmarkCombinators(b *testing.B) {
+	some, none := Some(42), None[int]()
+	positive := func(x int) bool { return x > 0 }
+	double := func(x int) int { return x * 2 }
+
+	b.Run("And", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = some.And(none)
+		}
+	})
+
+	b.Run("AndThen", func(b *testing.B) {
+		next := func(x int) Optional[int] { return Some(x + 1) }
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = some.AndThen(next)
+		}
+	})
+
+	b.Run("Xor", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = some.Xor(none)
+		}
+	})
+
+	b.Run("MapOr", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = MapOr(some, 0, double)
+		}
+	})
+
+	b.Run("MapOrElse", func(b *testing.B) {
+		zero := func() int { return 0 }
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = MapOrElse(none, zero, double)
+		}
+	})
+
+	b.Run("Inspect", func(b *testing.B) {
+		sink := 0
+		record := func(x int) { sink += x }
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = some.Inspect(record)
+		}
+	})
+
+	b.Run("Take", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			opt := some
+			_ = opt.Take()
+		}
+	})
+
+	b.Run("Replace", func(b *testing.B) {
+		opt := some
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = opt.Replace(i)
+		}
+	})
+
+	b.Run("Insert", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			opt := none
+			_ = opt.Insert(i)
+		}
+	})
+
+	b.Run("GetOrInsert", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			opt := none
+			_ = opt.GetOrInsert(i)
+		}
+	})
+
+	b.Run("GetOrInsertWith", func(b *testing.B) {
+		supplier := func() int { return 1 }
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			opt := none
+			_ = opt.GetOrInsertWith(supplier)
+		}
+	})
+
+	b.Run("IsSomeAnd", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = some.IsSomeAnd(positive)
+		}
+	})
+
+	b.Run("IsNoneOr", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = none.IsNoneOr(positive)
+		}
+	})
+
+	b.Run("Flatten", func(b *testing.B) {
+		nested := Some(some)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Flatten(nested)
+		}
+	})
+}
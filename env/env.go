@@ -0,0 +1,235 @@
+// Package env populates structs from environment variables.
+//
+// Fields are bound to variables with an `env:"NAME"` tag. A field of type
+// optional.Optional[T] is set to None when its variable is absent and to Some of
+// the parsed value when it is present, even if the value is empty, so callers can
+// tell an unset variable from one set to an empty or zero value. Other fields keep
+// their current value when the variable is absent.
+//
+// Nested struct fields are loaded recursively. The tag of a nested struct field,
+// if any, is added to the prefix of every variable inside it:
+//
+//	type Config struct {
+//		Port optional.Optional[int] `env:"PORT"`
+//		DB   struct {
+//			Host optional.Optional[string] `env:"HOST"`
+//		} `env:"DB_"`
+//	}
+//
+// With Loader{Prefix: "APP_"} the fields above read APP_PORT and APP_DB_HOST.
+// A nil pointer to a nested struct is only allocated when at least one variable
+// under its prefix is set.
+package env
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"time"
+
+	// The optional package installs the reflectopt hooks when initialized.
+	_ "github.com/vuongnq9x/optional"
+	"github.com/vuongnq9x/optional/internal/reflectopt"
+)
+
+// LookupFunc looks up an environment variable, reporting whether it is set.
+// os.LookupEnv is the default.
+type LookupFunc func(name string) (string, bool)
+
+// Loader populates structs from environment variables.
+// The zero value reads the process environment without a prefix.
+type Loader struct {
+	// Prefix is prepended to every variable name.
+	Prefix string
+	// Lookup is used to read variables. If nil, os.LookupEnv is used.
+	Lookup LookupFunc
+}
+
+// ParseError reports a variable whose value could not be parsed into its field.
+type ParseError struct {
+	Var   string
+	Field string
+	Err   error
+}
+
+// Error implements the error interface
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("env: parsing %s into field %s: %v", e.Var, e.Field, e.Err)
+}
+
+// Unwrap returns the underlying parse error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Load populates dst from the process environment using a zero Loader.
+func Load(dst any) error {
+	return Loader{}.Load(dst)
+}
+
+// Load populates the struct pointed to by dst.
+// Every field is processed even when some fail; all parse failures are
+// returned together, joined with errors.Join, each as a *ParseError.
+func (l Loader) Load(dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("env: Load destination must be a non-nil pointer to a struct, got %T", dst)
+	}
+	lookup := l.Lookup
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+	_, errs := loadStruct(v.Elem(), l.Prefix, "", lookup)
+	return errors.Join(errs...)
+}
+
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// loadStruct loads the fields of v and reports whether any of their variables was set.
+func loadStruct(v reflect.Value, prefix, path string, lookup LookupFunc) (bool, []error) {
+	var errs []error
+	found := false
+	t := v.Type()
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag, hasTag := f.Tag.Lookup("env")
+		if tag == "-" {
+			continue
+		}
+		fv := v.Field(i)
+		fieldPath := path + f.Name
+
+		if reflectopt.IsOptional(f.Type) {
+			if !hasTag {
+				continue
+			}
+			ok, err := loadOptional(fv, prefix+tag, fieldPath, lookup)
+			found = found || ok
+			if err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+
+		if isNested(f.Type) {
+			if fv.Kind() != reflect.Pointer {
+				ok, nestedErrs := loadStruct(fv, prefix+tag, fieldPath+".", lookup)
+				found = found || ok
+				errs = append(errs, nestedErrs...)
+				continue
+			}
+			// A nil pointer is only allocated when one of its variables is set,
+			// so that an unset section stays distinguishable from an empty one.
+			target := fv
+			if target.IsNil() {
+				target = reflect.New(fv.Type().Elem())
+			}
+			ok, nestedErrs := loadStruct(target.Elem(), prefix+tag, fieldPath+".", lookup)
+			if ok && fv.IsNil() {
+				fv.Set(target)
+			}
+			found = found || ok
+			errs = append(errs, nestedErrs...)
+			continue
+		}
+
+		if !hasTag {
+			continue
+		}
+		name := prefix + tag
+		raw, ok := lookup(name)
+		if !ok {
+			continue
+		}
+		found = true
+		if err := parseValue(fv, raw); err != nil {
+			errs = append(errs, &ParseError{Var: name, Field: fieldPath, Err: err})
+		}
+	}
+	return found, errs
+}
+
+// loadOptional sets an Optional field from its variable, or to None when it is absent.
+// It reports whether the variable was set.
+func loadOptional(fv reflect.Value, name, fieldPath string, lookup LookupFunc) (bool, error) {
+	raw, ok := lookup(name)
+	if !ok {
+		fv.SetZero()
+		return false, nil
+	}
+	value := reflect.New(reflectopt.Elem(fv.Type())).Elem()
+	if err := parseValue(value, raw); err != nil {
+		return true, &ParseError{Var: name, Field: fieldPath, Err: err}
+	}
+	reflectopt.Set(fv, value)
+	return true, nil
+}
+
+// isNested reports whether t is a struct, or pointer to one, to be loaded recursively.
+func isNested(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && !reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// parseValue parses raw into v through encoding.TextUnmarshaler, time.ParseDuration or strconv.
+func parseValue(v reflect.Value, raw string) error {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return parseValue(v.Elem(), raw)
+	}
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(raw))
+	}
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package env
+
+import (
+	"errors"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/vuongnq9x/optional"
+)
+
+type dbConfig struct {
+	Host optional.Optional[string] `env:"HOST"`
+	Port optional.Optional[int]    `env:"PORT"`
+}
+
+type config struct {
+	Name    optional.Optional[string]        `env:"NAME"`
+	Debug   optional.Optional[bool]          `env:"DEBUG"`
+	Timeout optional.Optional[time.Duration] `env:"TIMEOUT"`
+	Addr    optional.Optional[netip.Addr]    `env:"ADDR"`
+	Workers int                              `env:"WORKERS"`
+	Ratio   float64                          `env:"RATIO"`
+	DB      dbConfig                         `env:"DB_"`
+	Cache   *dbConfig                        `env:"CACHE_"`
+	Plain   dbConfig
+	Skipped optional.Optional[string] `env:"-"`
+	NoTag   optional.Optional[string]
+}
+
+// lookalike has the method names of an Optional without being one.
+type lookalike struct{ value string }
+
+func (l lookalike) IsPresent() bool { return l.value != "" }
+
+func (l *lookalike) Replace(value string) lookalike {
+	old := *l
+	l.value = value
+	return old
+}
+
+func mapLookup(m map[string]string) LookupFunc {
+	return func(name string) (string, bool) {
+		v, ok := m[name]
+		return v, ok
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("Absent variables are None", func(t *testing.T) {
+		cfg := config{Name: optional.Some("stale"), Workers: 4}
+		if err := (Loader{Lookup: mapLookup(nil)}).Load(&cfg); err != nil {
+			t.Fatalf("Load error: %v", err)
+		}
+		if cfg.Name.IsPresent() || cfg.Debug.IsPresent() || cfg.DB.Host.IsPresent() {
+			t.Errorf("Absent variables should be None: %+v", cfg)
+		}
+		if cfg.Workers != 4 {
+			t.Errorf("Plain field should keep its value, got %d", cfg.Workers)
+		}
+		if cfg.Cache != nil {
+			t.Errorf("Nested pointer should stay nil when none of its variables are set, got %+v", cfg.Cache)
+		}
+	})
+
+	t.Run("Nested pointer allocated on parse error", func(t *testing.T) {
+		var cfg config
+		err := (Loader{Lookup: mapLookup(map[string]string{"CACHE_PORT": "x"})}).Load(&cfg)
+		if err == nil {
+			t.Fatal("Expected parse error")
+		}
+		if cfg.Cache == nil {
+			t.Error("Nested pointer should be allocated when one of its variables is set")
+		}
+	})
+
+	t.Run("Empty and zero values are present", func(t *testing.T) {
+		var cfg config
+		env := map[string]string{"NAME": "", "DEBUG": "false", "DB_PORT": "0"}
+		if err := (Loader{Lookup: mapLookup(env)}).Load(&cfg); err != nil {
+			t.Fatalf("Load error: %v", err)
+		}
+		if !optional.Equal(cfg.Name, optional.Some("")) {
+			t.Errorf("Expected Some(), got %v", cfg.Name)
+		}
+		if !optional.Equal(cfg.Debug, optional.Some(false)) {
+			t.Errorf("Expected Some(false), got %v", cfg.Debug)
+		}
+		if !optional.Equal(cfg.DB.Port, optional.Some(0)) {
+			t.Errorf("Expected Some(0), got %v", cfg.DB.Port)
+		}
+	})
+
+	t.Run("Parses supported types", func(t *testing.T) {
+		var cfg config
+		env := map[string]string{
+			"TIMEOUT": "1m30s",
+			"ADDR":    "10.0.0.1",
+			"WORKERS": "8",
+			"RATIO":   "0.5",
+		}
+		if err := (Loader{Lookup: mapLookup(env)}).Load(&cfg); err != nil {
+			t.Fatalf("Load error: %v", err)
+		}
+		if cfg.Timeout.OrElse(0) != 90*time.Second {
+			t.Errorf("Expected Some(1m30s), got %v", cfg.Timeout)
+		}
+		if cfg.Addr.OrElse(netip.Addr{}).String() != "10.0.0.1" {
+			t.Errorf("Expected Some(10.0.0.1), got %v", cfg.Addr)
+		}
+		if cfg.Workers != 8 || cfg.Ratio != 0.5 {
+			t.Errorf("Unexpected plain fields: %d %v", cfg.Workers, cfg.Ratio)
+		}
+	})
+
+	t.Run("Prefix and nested structs", func(t *testing.T) {
+		var cfg config
+		env := map[string]string{
+			"APP_NAME":       "svc",
+			"APP_DB_HOST":    "db.local",
+			"APP_CACHE_PORT": "6379",
+			"APP_HOST":       "plain.local",
+			"APP_SKIPPED":    "x",
+			"APP_NOTAG":      "x",
+			"NAME":           "unprefixed",
+		}
+		if err := (Loader{Prefix: "APP_", Lookup: mapLookup(env)}).Load(&cfg); err != nil {
+			t.Fatalf("Load error: %v", err)
+		}
+		if cfg.Name.OrElse("") != "svc" {
+			t.Errorf("Expected Some(svc), got %v", cfg.Name)
+		}
+		if cfg.DB.Host.OrElse("") != "db.local" {
+			t.Errorf("Expected Some(db.local), got %v", cfg.DB.Host)
+		}
+		if cfg.Cache == nil || cfg.Cache.Port.OrElse(0) != 6379 {
+			t.Errorf("Expected cache port Some(6379), got %+v", cfg.Cache)
+		}
+		if cfg.Plain.Host.OrElse("") != "plain.local" {
+			t.Errorf("Expected Some(plain.local), got %v", cfg.Plain.Host)
+		}
+		if cfg.Skipped.IsPresent() || cfg.NoTag.IsPresent() {
+			t.Error("Skipped and untagged fields should not be loaded")
+		}
+	})
+
+	t.Run("Aggregates parse errors", func(t *testing.T) {
+		var cfg config
+		env := map[string]string{
+			"DEBUG":   "maybe",
+			"WORKERS": "many",
+			"DB_PORT": "http",
+			"NAME":    "ok",
+		}
+		err := (Loader{Lookup: mapLookup(env)}).Load(&cfg)
+		if err == nil {
+			t.Fatal("Expected parse errors")
+		}
+
+		var pe *ParseError
+		if !errors.As(err, &pe) || pe.Var != "DEBUG" || pe.Field != "Debug" {
+			t.Errorf("Expected first ParseError for DEBUG, got %v", pe)
+		}
+		if !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("Expected wrapped strconv.ErrSyntax, got %v", err)
+		}
+		joined, ok := err.(interface{ Unwrap() []error })
+		if !ok || len(joined.Unwrap()) != 3 {
+			t.Errorf("Expected 3 errors, got %v", err)
+		}
+		if cfg.Name.OrElse("") != "ok" {
+			t.Errorf("Valid fields should still load, got %v", cfg.Name)
+		}
+	})
+
+	t.Run("Process environment", func(t *testing.T) {
+		t.Setenv("OPTIONAL_ENV_TEST_NAME", "from-env")
+		var cfg struct {
+			Name optional.Optional[string] `env:"OPTIONAL_ENV_TEST_NAME"`
+		}
+		if err := Load(&cfg); err != nil {
+			t.Fatalf("Load error: %v", err)
+		}
+		if cfg.Name.OrElse("") != "from-env" {
+			t.Errorf("Expected Some(from-env), got %v", cfg.Name)
+		}
+	})
+
+	t.Run("Lookalike types are not Optionals", func(t *testing.T) {
+		var cfg struct {
+			Name lookalike `env:"NAME"`
+		}
+		if err := (Loader{Lookup: mapLookup(map[string]string{"NAME": "x"})}).Load(&cfg); err != nil {
+			t.Fatalf("Load error: %v", err)
+		}
+		if cfg.Name.value != "" {
+			t.Errorf("Lookalike type should be left alone, got %q", cfg.Name.value)
+		}
+	})
+
+	t.Run("Rejects invalid destination", func(t *testing.T) {
+		if err := Load(config{}); err == nil {
+			t.Error("Expected error for non-pointer destination")
+		}
+	})
+}
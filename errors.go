@@ -0,0 +1,84 @@
+package optional
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"runtime"
+)
+
+// ErrEmpty is the sentinel for accessing the value of an empty Optional.
+// Errors returned by GetOrErr and values panicked by Get and OrElsePanic
+// all match it with errors.Is.
+var ErrEmpty = errors.New("optional: empty Optional")
+
+// EmptyError describes an attempt to access the value of an empty Optional.
+// Get and OrElsePanic panic with a *EmptyError.
+type EmptyError struct {
+	// Op is the method that was called, such as "Get".
+	Op string
+	// Type is the name of the Optional's element type.
+	Type string
+	// Message is the caller-supplied message, if any.
+	Message string
+	// Caller is the file:line of the call for panics and Must failures.
+	// It is empty for errors returned by GetOrErr, which are meant for ordinary
+	// control flow and do not pay for a stack lookup.
+	Caller string
+}
+
+// Error implements the error interface
+func (e *EmptyError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = fmt.Sprintf("optional: %s called on empty Optional[%s]", e.Op, e.Type)
+	}
+	if e.Caller != "" {
+		msg += " at " + e.Caller
+	}
+	return msg
+}
+
+// Is reports whether target is ErrEmpty
+func (e *EmptyError) Is(target error) bool {
+	return target == ErrEmpty
+}
+
+// newEmptyError creates an EmptyError for T, recording the caller skip frames
+// above the function that calls newEmptyError.
+func newEmptyError[T any](op, message string, skip int) *EmptyError {
+	err := &EmptyError{Op: op, Type: reflect.TypeFor[T]().String(), Message: message}
+	if _, file, line, ok := runtime.Caller(skip + 1); ok {
+		err.Caller = fmt.Sprintf("%s:%d", filepath.Base(file), line)
+	}
+	return err
+}
+
+// GetOrErr returns the value, or a *EmptyError matching ErrEmpty if empty
+func (o Optional[T]) GetOrErr() (T, error) {
+	if !o.present {
+		var zero T
+		return zero, &EmptyError{Op: "GetOrErr", Type: reflect.TypeFor[T]().String()}
+	}
+	return o.value, nil
+}
+
+// OkOr converts the Optional to a Result, using err when empty,
+// or a *EmptyError matching ErrEmpty if err is nil
+func (o Optional[T]) OkOr(err error) Result[T] {
+	return OkOr(o, err)
+}
+
+// Must returns the value of opt, failing the test through t.Fatalf if it is empty.
+// t is typically a *testing.T or *testing.B.
+func Must[T any](t interface {
+	Helper()
+	Fatalf(format string, args ...any)
+}, opt Optional[T]) T {
+	t.Helper()
+	if !opt.present {
+		t.Fatalf("%v", newEmptyError[T]("Must", "", 1))
+	}
+	return opt.value
+}
@@ -0,0 +1,134 @@
+package optional
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func recoverPanic(f func()) (r any) {
+	defer func() { r = recover() }()
+	f()
+	return nil
+}
+
+func TestEmptyError(t *testing.T) {
+	t.Run("Get panics with EmptyError", func(t *testing.T) {
+		r := recoverPanic(func() { None[int]().Get() })
+		err, ok := r.(*EmptyError)
+		if !ok {
+			t.Fatalf("Expected *EmptyError, got %T", r)
+		}
+		if !errors.Is(err, ErrEmpty) {
+			t.Error("EmptyError should match ErrEmpty")
+		}
+		if err.Op != "Get" || err.Type != "int" {
+			t.Errorf("Unexpected error fields: %+v", err)
+		}
+		if !strings.HasPrefix(err.Caller, "errors_test.go:") {
+			t.Errorf("Expected caller in errors_test.go, got %q", err.Caller)
+		}
+		if !strings.HasPrefix(err.Error(), "optional: Get called on empty Optional[int] at errors_test.go:") {
+			t.Errorf("Unexpected message: %s", err.Error())
+		}
+	})
+
+	t.Run("OrElsePanic panics with message", func(t *testing.T) {
+		r := recoverPanic(func() { None[string]().OrElsePanic("config missing") })
+		err, ok := r.(*EmptyError)
+		if !ok {
+			t.Fatalf("Expected *EmptyError, got %T", r)
+		}
+		if !errors.Is(err, ErrEmpty) || err.Op != "OrElsePanic" || err.Type != "string" {
+			t.Errorf("Unexpected error: %+v", err)
+		}
+		if !strings.HasPrefix(err.Error(), "config missing at ") {
+			t.Errorf("Unexpected message: %s", err.Error())
+		}
+	})
+
+	t.Run("Distinguishable from other panics", func(t *testing.T) {
+		r := recoverPanic(func() { panic(errors.New("other")) })
+		if err, ok := r.(error); !ok || errors.Is(err, ErrEmpty) {
+			t.Errorf("Other panics should not match ErrEmpty: %v", r)
+		}
+	})
+
+	t.Run("Wrapped EmptyError", func(t *testing.T) {
+		_, err := None[int]().GetOrErr()
+		wrapped := fmt.Errorf("loading: %w", err)
+		var emptyErr *EmptyError
+		if !errors.Is(wrapped, ErrEmpty) || !errors.As(wrapped, &emptyErr) {
+			t.Error("Wrapped EmptyError should match ErrEmpty and *EmptyError")
+		}
+	})
+}
+
+func TestGetOrErr(t *testing.T) {
+	t.Run("GetOrErr with Some", func(t *testing.T) {
+		v, err := Some(42).GetOrErr()
+		if v != 42 || err != nil {
+			t.Errorf("Expected (42, nil), got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("GetOrErr with None", func(t *testing.T) {
+		v, err := None[int]().GetOrErr()
+		if v != 0 || !errors.Is(err, ErrEmpty) {
+			t.Errorf("Expected (0, ErrEmpty), got (%v, %v)", v, err)
+		}
+		if err.Error() != "optional: GetOrErr called on empty Optional[int]" {
+			t.Errorf("Unexpected message: %v", err)
+		}
+	})
+
+	t.Run("GetOrErr with None allocates only the error", func(t *testing.T) {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = None[int]().GetOrErr()
+		})
+		if allocs > 1 {
+			t.Errorf("Expected at most 1 allocation, got %v", allocs)
+		}
+	})
+}
+
+func TestOkOrMethod(t *testing.T) {
+	if r := Some(1).OkOr(errTest); r.OrElse(0) != 1 {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+	if r := None[int]().OkOr(errTest); r.Err() != errTest {
+		t.Errorf("Expected Err(errTest), got %v", r)
+	}
+}
+
+type fakeTB struct {
+	failed bool
+	msg    string
+}
+
+func (f *fakeTB) Helper() {}
+
+func (f *fakeTB) Fatalf(format string, args ...any) {
+	f.failed = true
+	f.msg = fmt.Sprintf(format, args...)
+}
+
+func TestMust(t *testing.T) {
+	t.Run("Must with Some", func(t *testing.T) {
+		if v := Must(t, Some("x")); v != "x" {
+			t.Errorf("Expected 'x', got %v", v)
+		}
+	})
+
+	t.Run("Must with None", func(t *testing.T) {
+		tb := &fakeTB{}
+		Must(tb, None[int]())
+		if !tb.failed {
+			t.Error("Must with None should fail the test")
+		}
+		if !strings.Contains(tb.msg, "Must called on empty Optional[int]") {
+			t.Errorf("Unexpected message: %s", tb.msg)
+		}
+	})
+}
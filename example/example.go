@@ -60,8 +60,5 @@ func main() {
 }
 
 func parseNumber(s string) optional.Optional[int] {
-	if num, err := strconv.Atoi(s); err == nil {
-		return optional.Some(num)
-	}
-	return optional.None[int]()
+	return optional.FromErr(strconv.Atoi(s))
 }
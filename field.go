@@ -0,0 +1,149 @@
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FieldState describes which of the three states a Field is in.
+type FieldState uint8
+
+const (
+	// FieldUnset means the field was not provided at all.
+	FieldUnset FieldState = iota
+	// FieldNull means the field was explicitly provided as null.
+	FieldNull
+	// FieldValue means the field was provided with a value.
+	FieldValue
+)
+
+// String returns string representation
+func (s FieldState) String() string {
+	switch s {
+	case FieldUnset:
+		return "Unset"
+	case FieldNull:
+		return "Null"
+	case FieldValue:
+		return "Value"
+	}
+	return fmt.Sprintf("FieldState(%d)", uint8(s))
+}
+
+// Field represents a value that may be absent, explicitly null, or present.
+// Unlike Optional, a Field keeps a missing JSON key apart from an explicit null,
+// which makes it suitable for PATCH-style request bodies.
+// The zero value is an unset Field.
+type Field[T any] struct {
+	value T
+	state FieldState
+}
+
+// UnsetField creates a Field that was not provided
+func UnsetField[T any]() Field[T] {
+	return Field[T]{}
+}
+
+// NullField creates a Field that was explicitly set to null
+func NullField[T any]() Field[T] {
+	return Field[T]{state: FieldNull}
+}
+
+// SetField creates a Field with a value
+func SetField[T any](value T) Field[T] {
+	return Field[T]{value: value, state: FieldValue}
+}
+
+// FieldFromOptional creates a Field from an Optional.
+// Some becomes a Field with a value and None becomes an explicit null.
+func FieldFromOptional[T any](opt Optional[T]) Field[T] {
+	if opt.present {
+		return SetField(opt.value)
+	}
+	return NullField[T]()
+}
+
+// State returns the state of the Field
+func (f Field[T]) State() FieldState {
+	return f.state
+}
+
+// IsUnset returns true if the Field was not provided
+func (f Field[T]) IsUnset() bool {
+	return f.state == FieldUnset
+}
+
+// IsNull returns true if the Field was explicitly set to null
+func (f Field[T]) IsNull() bool {
+	return f.state == FieldNull
+}
+
+// HasValue returns true if the Field contains a value
+func (f Field[T]) HasValue() bool {
+	return f.state == FieldValue
+}
+
+// Get returns the value, panics if the Field has no value
+func (f Field[T]) Get() T {
+	if f.state != FieldValue {
+		panic("called Get() on Field without value")
+	}
+	return f.value
+}
+
+// OrElse returns the value or a default value if the Field has no value
+func (f Field[T]) OrElse(defaultValue T) T {
+	if f.state == FieldValue {
+		return f.value
+	}
+	return defaultValue
+}
+
+// Optional converts the Field to an Optional.
+// Returns Some if the Field has a value, otherwise None.
+func (f Field[T]) Optional() Optional[T] {
+	if f.state == FieldValue {
+		return Some(f.value)
+	}
+	return None[T]()
+}
+
+// IsZero reports whether the Field is unset.
+// Together with the omitzero tag option this drops unset fields from JSON output.
+func (f Field[T]) IsZero() bool {
+	return f.state == FieldUnset
+}
+
+// String returns string representation
+func (f Field[T]) String() string {
+	if f.state == FieldValue {
+		return fmt.Sprintf("Value(%v)", f.value)
+	}
+	return f.state.String()
+}
+
+// MarshalJSON implements json.Marshaler.
+// Unset and null Fields are both written as null; use omitzero to omit unset ones.
+func (f Field[T]) MarshalJSON() ([]byte, error) {
+	if f.state == FieldValue {
+		return json.Marshal(f.value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It is only called for keys present in the input, so a missing key leaves the Field unset.
+func (f *Field[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*f = NullField[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*f = SetField(value)
+	return nil
+}
@@ -0,0 +1,148 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type patchRequest struct {
+	Name  Field[string] `json:"name,omitzero"`
+	Email Field[string] `json:"email,omitzero"`
+	Age   Field[int]    `json:"age,omitzero"`
+}
+
+func TestFieldConstructors(t *testing.T) {
+	t.Run("UnsetField", func(t *testing.T) {
+		f := UnsetField[int]()
+		if !f.IsUnset() || f.IsNull() || f.HasValue() {
+			t.Errorf("Expected Unset, got %v", f.State())
+		}
+	})
+
+	t.Run("Zero value is unset", func(t *testing.T) {
+		var f Field[int]
+		if f.State() != FieldUnset {
+			t.Errorf("Expected Unset, got %v", f.State())
+		}
+	})
+
+	t.Run("NullField", func(t *testing.T) {
+		f := NullField[int]()
+		if f.IsUnset() || !f.IsNull() || f.HasValue() {
+			t.Errorf("Expected Null, got %v", f.State())
+		}
+	})
+
+	t.Run("SetField", func(t *testing.T) {
+		f := SetField(42)
+		if !f.HasValue() || f.Get() != 42 {
+			t.Errorf("Expected Value(42), got %v", f)
+		}
+	})
+
+	t.Run("Get without value should panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Get on null Field should panic")
+			}
+		}()
+		NullField[int]().Get()
+	})
+}
+
+func TestFieldOptional(t *testing.T) {
+	t.Run("Field to Optional", func(t *testing.T) {
+		if opt := SetField(1).Optional(); opt.OrElse(0) != 1 {
+			t.Errorf("Expected Some(1), got %v", opt)
+		}
+		if opt := NullField[int]().Optional(); opt.IsPresent() {
+			t.Errorf("Expected None, got %v", opt)
+		}
+		if opt := UnsetField[int]().Optional(); opt.IsPresent() {
+			t.Errorf("Expected None, got %v", opt)
+		}
+	})
+
+	t.Run("Optional to Field", func(t *testing.T) {
+		if f := FieldFromOptional(Some(1)); !f.HasValue() || f.Get() != 1 {
+			t.Errorf("Expected Value(1), got %v", f)
+		}
+		if f := FieldFromOptional(None[int]()); !f.IsNull() {
+			t.Errorf("Expected Null, got %v", f)
+		}
+	})
+}
+
+func TestFieldString(t *testing.T) {
+	tests := map[string]string{
+		UnsetField[int]().String(): "Unset",
+		NullField[int]().String():  "Null",
+		SetField(42).String():      "Value(42)",
+	}
+	for got, want := range tests {
+		if got != want {
+			t.Errorf("Expected %q, got %q", want, got)
+		}
+	}
+}
+
+func TestFieldJSON(t *testing.T) {
+	t.Run("Unmarshal distinguishes absent, null and value", func(t *testing.T) {
+		var req patchRequest
+		if err := json.Unmarshal([]byte(`{"name":"bob","email":null}`), &req); err != nil {
+			t.Fatalf("Unmarshal error: %v", err)
+		}
+		if !req.Name.HasValue() || req.Name.Get() != "bob" {
+			t.Errorf("Expected name Value(bob), got %v", req.Name)
+		}
+		if !req.Email.IsNull() {
+			t.Errorf("Expected email Null, got %v", req.Email)
+		}
+		if !req.Age.IsUnset() {
+			t.Errorf("Expected age Unset, got %v", req.Age)
+		}
+	})
+
+	t.Run("Marshal omits unset fields", func(t *testing.T) {
+		req := patchRequest{Name: SetField("bob"), Email: NullField[string]()}
+		data, err := json.Marshal(req)
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		if string(data) != `{"name":"bob","email":null}` {
+			t.Errorf("Unexpected JSON: %s", string(data))
+		}
+	})
+
+	t.Run("Marshal keeps zero value", func(t *testing.T) {
+		data, err := json.Marshal(patchRequest{Age: SetField(0)})
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		if string(data) != `{"age":0}` {
+			t.Errorf("Unexpected JSON: %s", string(data))
+		}
+	})
+
+	t.Run("Roundtrip", func(t *testing.T) {
+		input := `{"email":null,"age":30}`
+		var req patchRequest
+		if err := json.Unmarshal([]byte(input), &req); err != nil {
+			t.Fatalf("Unmarshal error: %v", err)
+		}
+		data, err := json.Marshal(req)
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		if string(data) != input {
+			t.Errorf("Expected %s, got %s", input, string(data))
+		}
+	})
+
+	t.Run("Unmarshal invalid JSON", func(t *testing.T) {
+		var f Field[int]
+		if err := json.Unmarshal([]byte(`"x"`), &f); err == nil {
+			t.Error("Should return error for invalid JSON")
+		}
+	})
+}
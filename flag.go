@@ -0,0 +1,60 @@
+package optional
+
+import (
+	"flag"
+	"reflect"
+)
+
+// Flag adapts an Optional to flag.Value and flag.Getter.
+// The Optional stays None until the flag appears on the command line, so
+// IsPresent reports whether the user supplied it. Values are parsed with
+// *T's UnmarshalText method when available, and strconv otherwise.
+type Flag[T any] struct {
+	target *Optional[T]
+}
+
+// NewFlag creates a Flag that stores parsed values in opt.
+func NewFlag[T any](opt *Optional[T]) *Flag[T] {
+	return &Flag[T]{target: opt}
+}
+
+// FlagVar defines a flag with the given name and usage on fs whose value is stored in opt.
+// Leave opt as None before parsing so that IsPresent afterwards tells whether the flag was passed.
+func FlagVar[T any](fs *flag.FlagSet, opt *Optional[T], name, usage string) {
+	fs.Var(NewFlag(opt), name, usage)
+}
+
+// String implements flag.Value.
+// It returns the empty string for None, which flag.PrintDefaults treats as no default.
+func (f *Flag[T]) String() string {
+	if f == nil || f.target == nil || !f.target.present {
+		return ""
+	}
+	text, err := formatText(f.target.value)
+	if err != nil {
+		return ""
+	}
+	return string(text)
+}
+
+// Set implements flag.Value.
+func (f *Flag[T]) Set(s string) error {
+	var value T
+	if err := parseText(&value, s); err != nil {
+		return err
+	}
+	*f.target = Some(value)
+	return nil
+}
+
+// Get implements flag.Getter.
+// It returns the underlying Optional[T].
+func (f *Flag[T]) Get() any {
+	return *f.target
+}
+
+// IsBoolFlag reports whether the flag can be passed without a value, as with -v.
+// It is true when T is a boolean type.
+func (f *Flag[T]) IsBoolFlag() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Bool
+}
@@ -0,0 +1,148 @@
+package optional
+
+import (
+	"flag"
+	"io"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+func TestFlagVar(t *testing.T) {
+	t.Run("Passed flags are present", func(t *testing.T) {
+		var port Optional[int]
+		var host Optional[string]
+		var addr Optional[netip.Addr]
+		fs := newTestFlagSet()
+		FlagVar(fs, &port, "port", "listen port")
+		FlagVar(fs, &host, "host", "listen host")
+		FlagVar(fs, &addr, "addr", "bind address")
+
+		if err := fs.Parse([]string{"-port", "0", "-addr=127.0.0.1"}); err != nil {
+			t.Fatalf("Parse error: %v", err)
+		}
+		if !Equal(port, Some(0)) {
+			t.Errorf("Expected Some(0), got %v", port)
+		}
+		if host.IsPresent() {
+			t.Errorf("Expected None, got %v", host)
+		}
+		if addr.OrElse(netip.Addr{}).String() != "127.0.0.1" {
+			t.Errorf("Expected Some(127.0.0.1), got %v", addr)
+		}
+	})
+
+	t.Run("Empty string value is present", func(t *testing.T) {
+		var name Optional[string]
+		fs := newTestFlagSet()
+		FlagVar(fs, &name, "name", "")
+		if err := fs.Parse([]string{"-name="}); err != nil {
+			t.Fatalf("Parse error: %v", err)
+		}
+		if !Equal(name, Some("")) {
+			t.Errorf("Expected Some(), got %v", name)
+		}
+	})
+
+	t.Run("Bool flag without value", func(t *testing.T) {
+		var verbose, debug Optional[bool]
+		fs := newTestFlagSet()
+		FlagVar(fs, &verbose, "v", "verbose")
+		FlagVar(fs, &debug, "debug", "debug")
+		if err := fs.Parse([]string{"-v", "-debug=false"}); err != nil {
+			t.Fatalf("Parse error: %v", err)
+		}
+		if !Equal(verbose, Some(true)) || !Equal(debug, Some(false)) {
+			t.Errorf("Unexpected values: %v %v", verbose, debug)
+		}
+	})
+
+	t.Run("TextUnmarshaler value", func(t *testing.T) {
+		var since Optional[time.Time]
+		fs := newTestFlagSet()
+		FlagVar(fs, &since, "since", "")
+		if err := fs.Parse([]string{"-since", "2024-01-02T03:04:05Z"}); err != nil {
+			t.Fatalf("Parse error: %v", err)
+		}
+		if since.OrElse(time.Time{}).Year() != 2024 {
+			t.Errorf("Unexpected value: %v", since)
+		}
+	})
+
+	t.Run("Duration value", func(t *testing.T) {
+		var timeout Optional[time.Duration]
+		fs := newTestFlagSet()
+		FlagVar(fs, &timeout, "timeout", "")
+		if err := fs.Parse([]string{"-timeout=1m30s"}); err != nil {
+			t.Fatalf("Parse error: %v", err)
+		}
+		if !Equal(timeout, Some(90*time.Second)) {
+			t.Errorf("Expected Some(1m30s), got %v", timeout)
+		}
+		if got := NewFlag(&timeout).String(); got != "1m30s" {
+			t.Errorf("Expected '1m30s', got %q", got)
+		}
+	})
+
+	t.Run("Invalid value", func(t *testing.T) {
+		var port Optional[int]
+		fs := newTestFlagSet()
+		FlagVar(fs, &port, "port", "")
+		if err := fs.Parse([]string{"-port", "abc"}); err == nil {
+			t.Error("Should return error for invalid value")
+		}
+		if port.IsPresent() {
+			t.Errorf("Expected None, got %v", port)
+		}
+	})
+}
+
+func TestFlag(t *testing.T) {
+	t.Run("Getter", func(t *testing.T) {
+		var port Optional[int]
+		fs := newTestFlagSet()
+		FlagVar(fs, &port, "port", "")
+		if err := fs.Parse([]string{"-port=8080"}); err != nil {
+			t.Fatalf("Parse error: %v", err)
+		}
+		got := fs.Lookup("port").Value.(flag.Getter).Get()
+		if opt, ok := got.(Optional[int]); !ok || !Equal(opt, Some(8080)) {
+			t.Errorf("Expected Some(8080), got %v", got)
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		opt := None[float64]()
+		f := NewFlag(&opt)
+		if f.String() != "" {
+			t.Errorf("Expected empty string, got %q", f.String())
+		}
+		opt = Some(1.5)
+		if f.String() != "1.5" {
+			t.Errorf("Expected '1.5', got %q", f.String())
+		}
+		var zero *Flag[int]
+		if zero.String() != "" {
+			t.Errorf("Expected empty string for nil Flag, got %q", zero.String())
+		}
+	})
+
+	t.Run("PrintDefaults", func(t *testing.T) {
+		var port Optional[int]
+		fs := newTestFlagSet()
+		FlagVar(fs, &port, "port", "listen `port`")
+		var b strings.Builder
+		fs.SetOutput(&b)
+		fs.PrintDefaults()
+		if strings.Contains(b.String(), "default") {
+			t.Errorf("None flag should not print a default: %q", b.String())
+		}
+	})
+}
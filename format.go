@@ -0,0 +1,60 @@
+package optional
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+// LogValue implements slog.LogValuer.
+// None is logged as a null value and a present value is logged as the value itself,
+// resolving it through its own LogValue method when it has one.
+// Use LogAttr to leave None attributes out of the record instead.
+func (o Optional[T]) LogValue() slog.Value {
+	if !o.present {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(o.value).Resolve()
+}
+
+// LogAttr returns an slog.Attr for the Optional under key.
+// For None it returns the empty Attr, which handlers omit from the output.
+func LogAttr[T any](key string, opt Optional[T]) slog.Attr {
+	if !opt.present {
+		return slog.Attr{}
+	}
+	return slog.Attr{Key: key, Value: opt.LogValue()}
+}
+
+// Format implements fmt.Formatter.
+// The verb and flags are applied to the contained value, so Some(3.14159) printed
+// with %.2f gives "Some(3.14)" and %q quotes the value. %s behaves like %v.
+// %#v prints Go syntax, as GoString does. None prints as "None" for every verb.
+func (o Optional[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprint(f, o.GoString())
+		return
+	}
+	if !o.present {
+		fmt.Fprint(f, "None")
+		return
+	}
+	if verb == 's' {
+		verb = 'v'
+	}
+	fmt.Fprintf(f, "Some("+fmt.FormatString(f, verb)+")", o.value)
+}
+
+// GoString implements fmt.GoStringer.
+// It returns Go syntax such as optional.Some[int](42) or optional.None[string]().
+// A nil interface or pointer value is printed as nil.
+func (o Optional[T]) GoString() string {
+	typ := reflect.TypeFor[T]().String()
+	if !o.present {
+		return fmt.Sprintf("optional.None[%s]()", typ)
+	}
+	if v := reflect.ValueOf(&o.value).Elem(); (v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer) && v.IsNil() {
+		return fmt.Sprintf("optional.Some[%s](nil)", typ)
+	}
+	return fmt.Sprintf("optional.Some[%s](%#v)", typ, o.value)
+}
@@ -0,0 +1,106 @@
+package optional
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+type secret string
+
+func (secret) LogValue() slog.Value {
+	return slog.StringValue("REDACTED")
+}
+
+type point struct {
+	X, Y int
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		value  any
+		want   string
+	}{
+		{"%v", Some(42), "Some(42)"},
+		{"%s", Some("hi"), "Some(hi)"},
+		{"%v", None[int](), "None"},
+		{"%q", Some("hi"), `Some("hi")`},
+		{"%q", None[string](), "None"},
+		{"%+v", Some(point{1, 2}), "Some({X:1 Y:2})"},
+		{"%v", Some(point{1, 2}), "Some({1 2})"},
+		{"%.2f", Some(3.14159), "Some(3.14)"},
+		{"%x", Some(255), "Some(ff)"},
+		{"%#v", Some(42), "optional.Some[int](42)"},
+		{"%#v", Some("hi"), `optional.Some[string]("hi")`},
+		{"%#v", None[int](), "optional.None[int]()"},
+		{"%#v", Some(point{1, 2}), "optional.Some[optional.point](optional.point{X:1, Y:2})"},
+		{"%#v", Some[any](nil), "optional.Some[interface {}](nil)"},
+		{"%#v", Some[*int](nil), "optional.Some[*int](nil)"},
+		{"%v", []Optional[int]{Some(1), None[int]()}, "[Some(1) None]"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.value); got != tt.want {
+			t.Errorf("Sprintf(%q): expected %q, got %q", tt.format, tt.want, got)
+		}
+	}
+}
+
+func TestGoString(t *testing.T) {
+	if got := Some(1.5).GoString(); got != "optional.Some[float64](1.5)" {
+		t.Errorf("Unexpected GoString: %s", got)
+	}
+	if got := None[[]string]().GoString(); got != "optional.None[[]string]()" {
+		t.Errorf("Unexpected GoString: %s", got)
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	logLine := func(args ...any) string {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				if a.Key == slog.TimeKey || a.Key == slog.LevelKey {
+					return slog.Attr{}
+				}
+				return a
+			},
+		}))
+		logger.Info("msg", args...)
+		return strings.TrimSpace(buf.String())
+	}
+
+	t.Run("Some", func(t *testing.T) {
+		if got := logLine("port", Some(8080)); got != "msg=msg port=8080" {
+			t.Errorf("Unexpected log line: %s", got)
+		}
+	})
+
+	t.Run("None as null", func(t *testing.T) {
+		if got := logLine("port", None[int]()); got != "msg=msg port=<nil>" {
+			t.Errorf("Unexpected log line: %s", got)
+		}
+	})
+
+	t.Run("None omitted", func(t *testing.T) {
+		if got := logLine(LogAttr("port", None[int]()), LogAttr("host", Some("a"))); got != "msg=msg host=a" {
+			t.Errorf("Unexpected log line: %s", got)
+		}
+	})
+
+	t.Run("Inner LogValuer", func(t *testing.T) {
+		if got := logLine("token", Some(secret("hunter2"))); got != "msg=msg token=REDACTED" {
+			t.Errorf("Unexpected log line: %s", got)
+		}
+	})
+
+	t.Run("JSON handler", func(t *testing.T) {
+		var buf bytes.Buffer
+		slog.New(slog.NewJSONHandler(&buf, nil)).Info("msg", "a", Some(1), "b", None[int]())
+		if !strings.Contains(buf.String(), `"a":1,"b":null`) {
+			t.Errorf("Unexpected log line: %s", buf.String())
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package optional
+
+import "context"
+
+// Future is an asynchronous computation that resolves to an Optional.
+// It is safe for concurrent use. If the computation panics, Await re-panics
+// with the same value in every caller.
+type Future[T any] struct {
+	done       chan struct{}
+	cancel     context.CancelFunc
+	result     Optional[T]
+	panicked   bool
+	panicValue any
+}
+
+// Go starts fn in a new goroutine and returns a Future for its result.
+// fn receives a context derived from ctx that is cancelled when the Future is
+// cancelled or resolves, so fn should return promptly once it is done.
+func Go[T any](ctx context.Context, fn func(context.Context) Optional[T]) *Future[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	f := &Future[T]{done: make(chan struct{}), cancel: cancel}
+	go func() {
+		defer close(f.done)
+		defer cancel()
+		defer func() {
+			if r := recover(); r != nil {
+				f.panicked = true
+				f.panicValue = r
+			}
+		}()
+		f.result = fn(ctx)
+	}()
+	return f
+}
+
+// Await waits for the Future to resolve and returns its result.
+// Returns None if ctx is done first; the Future keeps running.
+func (f *Future[T]) Await(ctx context.Context) Optional[T] {
+	select {
+	case <-f.done:
+		if f.panicked {
+			panic(f.panicValue)
+		}
+		return f.result
+	case <-ctx.Done():
+		return None[T]()
+	}
+}
+
+// Done returns a channel that is closed when the Future has resolved
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Cancel cancels the context passed to the Future's function
+func (f *Future[T]) Cancel() {
+	f.cancel()
+}
+
+// Then returns a Future that transforms the result of f with mapper once it resolves, like Map.
+// The returned Future runs under ctx and resolves to None if ctx is done before f resolves.
+func Then[T, U any](ctx context.Context, f *Future[T], mapper func(T) U) *Future[U] {
+	return Go(ctx, func(ctx context.Context) Optional[U] {
+		return Map(f.Await(ctx), mapper)
+	})
+}
+
+// outcome is how a Future resolved.
+type outcome[T any] struct {
+	index      int
+	opt        Optional[T]
+	panicked   bool
+	panicValue any
+}
+
+// awaitEach delivers the outcome of every future in completion order until
+// handle returns false, and reports whether it stopped early.
+// Futures that have not resolved when ctx is done are reported as None.
+func awaitEach[T any](ctx context.Context, futures []*Future[T], handle func(outcome[T]) bool) bool {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	outcomes := make(chan outcome[T], len(futures))
+	for i, f := range futures {
+		go func() {
+			select {
+			case <-f.done:
+				outcomes <- outcome[T]{i, f.result, f.panicked, f.panicValue}
+			case <-ctx.Done():
+				outcomes <- outcome[T]{index: i, opt: None[T]()}
+			}
+		}()
+	}
+
+	for range futures {
+		if !handle(<-outcomes) {
+			return true
+		}
+	}
+	return false
+}
+
+func cancelFutures[T any](futures []*Future[T]) {
+	for _, f := range futures {
+		f.Cancel()
+	}
+}
+
+// AwaitFirstSome waits for the first of the futures to resolve to a present value,
+// cancels the others and returns that value. Returns None if every future
+// resolves to None or ctx is done first. A panic in any future is re-raised.
+func AwaitFirstSome[T any](ctx context.Context, futures ...*Future[T]) Optional[T] {
+	result := None[T]()
+	var failed *outcome[T]
+	awaitEach(ctx, futures, func(o outcome[T]) bool {
+		switch {
+		case o.panicked:
+			failed = &o
+			return false
+		case o.opt.present:
+			result = o.opt
+			return false
+		}
+		return ctx.Err() == nil
+	})
+	if result.present || failed != nil {
+		cancelFutures(futures)
+	}
+	if failed != nil {
+		panic(failed.panicValue)
+	}
+	return result
+}
+
+// All returns a Future that resolves to Some of every result, in order, if all
+// futures resolve to present values, and to None otherwise, following Zip.
+// As soon as one future resolves to None, or panics, the others are cancelled.
+func All[T any](ctx context.Context, futures ...*Future[T]) *Future[[]T] {
+	return Go(ctx, func(ctx context.Context) Optional[[]T] {
+		values := make([]T, len(futures))
+		var failed *outcome[T]
+		complete := true
+		stopped := awaitEach(ctx, futures, func(o outcome[T]) bool {
+			switch {
+			case o.panicked:
+				failed = &o
+				return false
+			case !o.opt.present:
+				complete = false
+				return false
+			}
+			values[o.index] = o.opt.value
+			return true
+		})
+		if stopped {
+			cancelFutures(futures)
+		}
+		if failed != nil {
+			panic(failed.panicValue)
+		}
+		if !complete {
+			return None[[]T]()
+		}
+		return Some(values)
+	})
+}
@@ -0,0 +1,197 @@
+package optional
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// blockUntilCancelled is a future body that only finishes when cancelled.
+func blockUntilCancelled[T any](cancelled *atomic.Int32) func(context.Context) Optional[T] {
+	return func(ctx context.Context) Optional[T] {
+		<-ctx.Done()
+		cancelled.Add(1)
+		return None[T]()
+	}
+}
+
+func TestFuture(t *testing.T) {
+	t.Run("Await resolved value", func(t *testing.T) {
+		f := Go(context.Background(), func(context.Context) Optional[int] { return Some(42) })
+		if opt := f.Await(context.Background()); !Equal(opt, Some(42)) {
+			t.Errorf("Expected Some(42), got %v", opt)
+		}
+		<-f.Done()
+		if opt := f.Await(context.Background()); !Equal(opt, Some(42)) {
+			t.Errorf("Expected Some(42) on second Await, got %v", opt)
+		}
+	})
+
+	t.Run("Await not found", func(t *testing.T) {
+		f := Go(context.Background(), func(context.Context) Optional[int] { return None[int]() })
+		if opt := f.Await(context.Background()); opt.IsPresent() {
+			t.Errorf("Expected None, got %v", opt)
+		}
+	})
+
+	t.Run("Await context done", func(t *testing.T) {
+		var cancelled atomic.Int32
+		f := Go(context.Background(), blockUntilCancelled[int](&cancelled))
+		defer f.Cancel()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if opt := f.Await(ctx); opt.IsPresent() {
+			t.Errorf("Expected None, got %v", opt)
+		}
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		var cancelled atomic.Int32
+		f := Go(context.Background(), blockUntilCancelled[int](&cancelled))
+		f.Cancel()
+		<-f.Done()
+		if cancelled.Load() != 1 {
+			t.Error("Cancel should cancel the future's context")
+		}
+	})
+
+	t.Run("Parent context cancels future", func(t *testing.T) {
+		var cancelled atomic.Int32
+		ctx, cancel := context.WithCancel(context.Background())
+		f := Go(ctx, blockUntilCancelled[int](&cancelled))
+		cancel()
+		<-f.Done()
+		if cancelled.Load() != 1 {
+			t.Error("Parent cancellation should reach the future")
+		}
+	})
+
+	t.Run("Panic is re-raised on every Await", func(t *testing.T) {
+		f := Go(context.Background(), func(context.Context) Optional[int] { panic("boom") })
+		for range 2 {
+			if r := recoverPanic(func() { f.Await(context.Background()) }); r != "boom" {
+				t.Errorf("Expected panic 'boom', got %v", r)
+			}
+		}
+	})
+}
+
+func TestThen(t *testing.T) {
+	f := Go(context.Background(), func(context.Context) Optional[int] { return Some(21) })
+	doubled := Then(context.Background(), f, func(x int) int { return x * 2 })
+	if opt := doubled.Await(context.Background()); !Equal(opt, Some(42)) {
+		t.Errorf("Expected Some(42), got %v", opt)
+	}
+
+	none := Go(context.Background(), func(context.Context) Optional[int] { return None[int]() })
+	if opt := Then(context.Background(), none, func(x int) int { return x }).Await(context.Background()); opt.IsPresent() {
+		t.Errorf("Expected None, got %v", opt)
+	}
+
+	blocked := make(chan struct{})
+	defer close(blocked)
+	pending := Go(context.Background(), func(context.Context) Optional[int] {
+		<-blocked
+		return Some(1)
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	derived := Then(ctx, pending, func(x int) int { return x })
+	cancel()
+	if opt := derived.Await(context.Background()); opt.IsPresent() {
+		t.Errorf("Expected None after caller context is cancelled, got %v", opt)
+	}
+}
+
+func TestAwaitFirstSome(t *testing.T) {
+	t.Run("Returns first present and cancels the rest", func(t *testing.T) {
+		var cancelled atomic.Int32
+		ctx := context.Background()
+		slow := Go(ctx, blockUntilCancelled[string](&cancelled))
+		missing := Go(ctx, func(context.Context) Optional[string] { return None[string]() })
+		found := Go(ctx, func(context.Context) Optional[string] { return Some("cache") })
+
+		if opt := AwaitFirstSome(ctx, slow, missing, found); !Equal(opt, Some("cache")) {
+			t.Errorf("Expected Some(cache), got %v", opt)
+		}
+		<-slow.Done()
+		if cancelled.Load() != 1 {
+			t.Error("Remaining futures should be cancelled")
+		}
+	})
+
+	t.Run("All None", func(t *testing.T) {
+		ctx := context.Background()
+		a := Go(ctx, func(context.Context) Optional[int] { return None[int]() })
+		b := Go(ctx, func(context.Context) Optional[int] { return None[int]() })
+		if opt := AwaitFirstSome(ctx, a, b); opt.IsPresent() {
+			t.Errorf("Expected None, got %v", opt)
+		}
+	})
+
+	t.Run("Context done", func(t *testing.T) {
+		var cancelled atomic.Int32
+		f := Go(context.Background(), blockUntilCancelled[int](&cancelled))
+		defer f.Cancel()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+		if opt := AwaitFirstSome(ctx, f); opt.IsPresent() {
+			t.Errorf("Expected None, got %v", opt)
+		}
+	})
+
+	t.Run("No futures", func(t *testing.T) {
+		if opt := AwaitFirstSome[int](context.Background()); opt.IsPresent() {
+			t.Errorf("Expected None, got %v", opt)
+		}
+	})
+
+	t.Run("Panic is re-raised", func(t *testing.T) {
+		f := Go(context.Background(), func(context.Context) Optional[int] { panic("boom") })
+		if r := recoverPanic(func() { AwaitFirstSome(context.Background(), f) }); r != "boom" {
+			t.Errorf("Expected panic 'boom', got %v", r)
+		}
+	})
+}
+
+func TestAllFutures(t *testing.T) {
+	t.Run("All present", func(t *testing.T) {
+		ctx := context.Background()
+		futures := make([]*Future[int], 5)
+		for i := range futures {
+			futures[i] = Go(ctx, func(context.Context) Optional[int] {
+				time.Sleep(time.Duration(5-i) * time.Millisecond)
+				return Some(i)
+			})
+		}
+		opt := All(ctx, futures...).Await(ctx)
+		if !slices.Equal(opt.OrElse(nil), []int{0, 1, 2, 3, 4}) {
+			t.Errorf("Expected Some([0 1 2 3 4]), got %v", opt)
+		}
+	})
+
+	t.Run("None short-circuits and cancels", func(t *testing.T) {
+		var cancelled atomic.Int32
+		ctx := context.Background()
+		slow := Go(ctx, blockUntilCancelled[int](&cancelled))
+		missing := Go(ctx, func(context.Context) Optional[int] { return None[int]() })
+
+		if opt := All(ctx, slow, missing).Await(ctx); opt.IsPresent() {
+			t.Errorf("Expected None, got %v", opt)
+		}
+		<-slow.Done()
+		if cancelled.Load() != 1 {
+			t.Error("Remaining futures should be cancelled")
+		}
+	})
+
+	t.Run("Panic propagates", func(t *testing.T) {
+		ctx := context.Background()
+		f := Go(ctx, func(context.Context) Optional[int] { panic("boom") })
+		all := All(ctx, f)
+		if r := recoverPanic(func() { all.Await(ctx) }); r != "boom" {
+			t.Errorf("Expected panic 'boom', got %v", r)
+		}
+	})
+}
@@ -0,0 +1,35 @@
+//go:build inline
+
+package optional
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// TestBridgesInline checks that the compiler inlines the bridging constructors,
+// so wrapping a comma-ok or error result costs nothing over writing the if by hand.
+// It inspects compiler diagnostics, which vary between toolchains, so it only runs with
+//
+//	go test -tags inline -run TestBridgesInline
+func TestBridgesInline(t *testing.T) {
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not available")
+	}
+
+	out, err := exec.Command(goTool, "test", "-c", "-o", os.DevNull, "-gcflags=-m", ".").CombinedOutput()
+	if err != nil {
+		t.Fatalf("go test -c failed: %v\n%s", err, out)
+	}
+	for _, name := range []string{"FromOk", "FromErr", "Lookup", "Index", "TypeAssert", "Recv"} {
+		if !strings.Contains(string(out), "inlining call to "+name+"[") {
+			t.Errorf("%s is not inlined", name)
+		}
+	}
+	if !strings.Contains(string(out), "can inline Optional[go.shape.int].Unpack") {
+		t.Error("Unpack is not inlinable")
+	}
+}
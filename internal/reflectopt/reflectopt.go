@@ -0,0 +1,18 @@
+// Package reflectopt gives other packages in this module reflective access to
+// optional.Optional values without adding reflection helpers to its public API.
+//
+// The hooks are installed by package optional when it is initialized, so users
+// of this package must import it as well.
+package reflectopt
+
+import "reflect"
+
+var (
+	// IsOptional reports whether t is an optional.Optional[T] for some T.
+	IsOptional func(t reflect.Type) bool
+	// Elem returns T for a type optional.Optional[T].
+	Elem func(t reflect.Type) reflect.Type
+	// Set stores value, which must be assignable to T, in the addressable
+	// optional.Optional[T] dst and makes it present.
+	Set func(dst, value reflect.Value)
+)
@@ -0,0 +1,79 @@
+package optional
+
+import "iter"
+
+// All returns an iterator that yields the value if present.
+// The iterator yields nothing for None.
+func (o Optional[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.present {
+			yield(o.value)
+		}
+	}
+}
+
+// First returns the first element of the sequence, or None if it is empty.
+func First[T any](seq iter.Seq[T]) Optional[T] {
+	for v := range seq {
+		return Some(v)
+	}
+	return None[T]()
+}
+
+// Last returns the last element of the sequence, or None if it is empty.
+func Last[T any](seq iter.Seq[T]) Optional[T] {
+	result := None[T]()
+	for v := range seq {
+		result = Some(v)
+	}
+	return result
+}
+
+// Find returns the first element of the sequence that satisfies the predicate, or None.
+func Find[T any](seq iter.Seq[T], predicate func(T) bool) Optional[T] {
+	for v := range seq {
+		if predicate(v) {
+			return Some(v)
+		}
+	}
+	return None[T]()
+}
+
+// Nth returns the element at zero-based index n, or None if the sequence is shorter or n is negative.
+func Nth[T any](seq iter.Seq[T], n int) Optional[T] {
+	if n < 0 {
+		return None[T]()
+	}
+	i := 0
+	for v := range seq {
+		if i == n {
+			return Some(v)
+		}
+		i++
+	}
+	return None[T]()
+}
+
+// FilterMap returns an iterator that applies the mapper to each element
+// and yields the values of the Optionals that are present.
+func FilterMap[T, U any](seq iter.Seq[T], mapper func(T) Optional[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if opt := mapper(v); opt.present && !yield(opt.value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of the present Optionals in the sequence.
+// Nones are skipped.
+func Values[T any](seq iter.Seq[Optional[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for opt := range seq {
+			if opt.present && !yield(opt.value) {
+				return
+			}
+		}
+	}
+}
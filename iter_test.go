@@ -0,0 +1,139 @@
+package optional
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	t.Run("All with Some", func(t *testing.T) {
+		got := slices.Collect(Some(42).All())
+		if !slices.Equal(got, []int{42}) {
+			t.Errorf("Expected [42], got %v", got)
+		}
+	})
+
+	t.Run("All with None", func(t *testing.T) {
+		got := slices.Collect(None[int]().All())
+		if len(got) != 0 {
+			t.Errorf("Expected no elements, got %v", got)
+		}
+	})
+
+	t.Run("Range over All", func(t *testing.T) {
+		count := 0
+		for v := range Some("x").All() {
+			if v != "x" {
+				t.Errorf("Expected 'x', got %v", v)
+			}
+			count++
+		}
+		if count != 1 {
+			t.Errorf("Expected 1 iteration, got %d", count)
+		}
+	})
+}
+
+func TestFirstLast(t *testing.T) {
+	seq := slices.Values([]int{1, 2, 3})
+	empty := slices.Values([]int(nil))
+
+	if opt := First(seq); opt.OrElse(0) != 1 {
+		t.Errorf("Expected Some(1), got %v", opt)
+	}
+	if opt := Last(seq); opt.OrElse(0) != 3 {
+		t.Errorf("Expected Some(3), got %v", opt)
+	}
+	if opt := First(empty); opt.IsPresent() {
+		t.Errorf("Expected None, got %v", opt)
+	}
+	if opt := Last(empty); opt.IsPresent() {
+		t.Errorf("Expected None, got %v", opt)
+	}
+}
+
+func TestFirstStopsEarly(t *testing.T) {
+	pulled := 0
+	var seq iter.Seq[int] = func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	if opt := First(seq); opt.OrElse(-1) != 0 {
+		t.Errorf("Expected Some(0), got %v", opt)
+	}
+	if pulled != 1 {
+		t.Errorf("Expected 1 element pulled, got %d", pulled)
+	}
+}
+
+func TestFind(t *testing.T) {
+	seq := slices.Values([]int{1, 2, 3, 4})
+	if opt := Find(seq, func(x int) bool { return x%2 == 0 }); opt.OrElse(0) != 2 {
+		t.Errorf("Expected Some(2), got %v", opt)
+	}
+	if opt := Find(seq, func(x int) bool { return x > 10 }); opt.IsPresent() {
+		t.Errorf("Expected None, got %v", opt)
+	}
+}
+
+func TestNth(t *testing.T) {
+	seq := slices.Values([]string{"a", "b", "c"})
+	tests := []struct {
+		n    int
+		want Optional[string]
+	}{
+		{0, Some("a")},
+		{2, Some("c")},
+		{3, None[string]()},
+		{-1, None[string]()},
+	}
+	for _, tt := range tests {
+		if got := Nth(seq, tt.n); got != tt.want {
+			t.Errorf("Nth(%d): expected %v, got %v", tt.n, tt.want, got)
+		}
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	parse := func(s string) Optional[int] {
+		if n, err := strconv.Atoi(s); err == nil {
+			return Some(n)
+		}
+		return None[int]()
+	}
+
+	got := slices.Collect(FilterMap(slices.Values([]string{"1", "x", "3"}), parse))
+	if !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("Expected [1 3], got %v", got)
+	}
+
+	first := First(FilterMap(slices.Values([]string{"x", "5", "6"}), parse))
+	if first.OrElse(0) != 5 {
+		t.Errorf("Expected Some(5), got %v", first)
+	}
+}
+
+func TestValues(t *testing.T) {
+	t.Run("Values from slice", func(t *testing.T) {
+		opts := []Optional[int]{Some(1), None[int](), Some(3)}
+		got := slices.Collect(Values(slices.Values(opts)))
+		if !slices.Equal(got, []int{1, 3}) {
+			t.Errorf("Expected [1 3], got %v", got)
+		}
+	})
+
+	t.Run("Values from map", func(t *testing.T) {
+		m := map[string]Optional[int]{"a": Some(1), "b": None[int]()}
+		got := slices.Collect(Values(maps.Values(m)))
+		if !slices.Equal(got, []int{1}) {
+			t.Errorf("Expected [1], got %v", got)
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package optional
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Lazy is an Optional computed on first access and cached afterwards.
+// It is safe for concurrent use: the supplier runs at most once until Reset,
+// and concurrent callers wait for that run to finish. Once computed, reads take
+// a lock-free fast path. If the supplier panics, every access re-panics with the
+// same value, as with sync.OnceValue.
+// A Lazy must not be copied after first use.
+type Lazy[T any] struct {
+	mu       sync.Mutex
+	supplier func() Optional[T]
+	state    atomic.Pointer[lazyState[T]]
+}
+
+// lazyState is the outcome of one run of a Lazy's supplier.
+// It is never modified after being published.
+type lazyState[T any] struct {
+	result     Optional[T]
+	panicked   bool
+	panicValue any
+}
+
+// NewLazy creates a Lazy that computes its Optional with supplier
+func NewLazy[T any](supplier func() Optional[T]) *Lazy[T] {
+	return &Lazy[T]{supplier: supplier}
+}
+
+// Optional returns the computed Optional, running the supplier if needed
+func (l *Lazy[T]) Optional() Optional[T] {
+	s := l.state.Load()
+	if s == nil {
+		s = l.compute()
+	}
+	if s.panicked {
+		panic(s.panicValue)
+	}
+	return s.result
+}
+
+// compute runs the supplier under the lock unless another caller already has,
+// and publishes its result or panic.
+func (l *Lazy[T]) compute() *lazyState[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if s := l.state.Load(); s != nil {
+		return s
+	}
+	s := &lazyState[T]{}
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				s.panicked = true
+				s.panicValue = r
+			}
+		}()
+		s.result = l.supplier()
+	}()
+	l.state.Store(s)
+	return s
+}
+
+// Reset discards the cached result so that the next access runs the supplier again
+func (l *Lazy[T]) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.state.Store(nil)
+}
+
+// IsPresent returns true if the computed Optional contains a value
+func (l *Lazy[T]) IsPresent() bool {
+	return l.Optional().present
+}
+
+// IsEmpty returns true if the computed Optional is empty
+func (l *Lazy[T]) IsEmpty() bool {
+	return !l.Optional().present
+}
+
+// Get returns the computed value, panics with a *EmptyError if empty
+func (l *Lazy[T]) Get() T {
+	opt := l.Optional()
+	if !opt.present {
+		panic(newEmptyError[T]("Get", "", 1))
+	}
+	return opt.value
+}
+
+// OrElse returns the computed value or a default value if empty
+func (l *Lazy[T]) OrElse(defaultValue T) T {
+	return l.Optional().OrElse(defaultValue)
+}
+
+// OrElseGet returns the computed value or calls a supplier function if empty
+func (l *Lazy[T]) OrElseGet(supplier func() T) T {
+	return l.Optional().OrElseGet(supplier)
+}
+
+// IfPresent calls the consumer function if the computed value is present
+func (l *Lazy[T]) IfPresent(consumer func(T)) {
+	l.Optional().IfPresent(consumer)
+}
+
+// Filter returns a Lazy that keeps the computed value only if the predicate is true
+func (l *Lazy[T]) Filter(predicate func(T) bool) *Lazy[T] {
+	return NewLazy(func() Optional[T] {
+		return l.Optional().Filter(predicate)
+	})
+}
+
+// MapLazy returns a Lazy that transforms the value of l when first accessed.
+// The result is cached independently of l, so resetting l does not reset it.
+func MapLazy[T, U any](l *Lazy[T], mapper func(T) U) *Lazy[U] {
+	return NewLazy(func() Optional[U] {
+		return Map(l.Optional(), mapper)
+	})
+}
+
+// FlatMapLazy returns a Lazy that transforms the value of l to another Optional when first accessed.
+// The result is cached independently of l, so resetting l does not reset it.
+func FlatMapLazy[T, U any](l *Lazy[T], mapper func(T) Optional[U]) *Lazy[U] {
+	return NewLazy(func() Optional[U] {
+		return FlatMap(l.Optional(), mapper)
+	})
+}
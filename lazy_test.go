@@ -0,0 +1,199 @@
+package optional
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	t.Run("Computes once", func(t *testing.T) {
+		calls := 0
+		l := NewLazy(func() Optional[int] {
+			calls++
+			return Some(42)
+		})
+		if calls != 0 {
+			t.Error("Supplier should not run before first access")
+		}
+		for range 3 {
+			if l.Get() != 42 {
+				t.Errorf("Expected 42, got %v", l.Get())
+			}
+		}
+		if calls != 1 {
+			t.Errorf("Expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("Caches None", func(t *testing.T) {
+		calls := 0
+		l := NewLazy(func() Optional[string] {
+			calls++
+			return None[string]()
+		})
+		if l.IsPresent() || !l.IsEmpty() || l.OrElse("x") != "x" {
+			t.Error("Expected empty Lazy")
+		}
+		if calls != 1 {
+			t.Errorf("Expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("Reset recomputes", func(t *testing.T) {
+		n := 0
+		l := NewLazy(func() Optional[int] {
+			n++
+			return Some(n)
+		})
+		if l.Get() != 1 {
+			t.Errorf("Expected 1, got %v", l.Get())
+		}
+		l.Reset()
+		if l.Get() != 2 || l.Get() != 2 {
+			t.Errorf("Expected 2 after reset, got %v", l.Get())
+		}
+	})
+
+	t.Run("Get on None panics with EmptyError", func(t *testing.T) {
+		l := NewLazy(None[int])
+		r := recoverPanic(func() { l.Get() })
+		if err, ok := r.(error); !ok || !errors.Is(err, ErrEmpty) {
+			t.Errorf("Expected ErrEmpty panic, got %v", r)
+		}
+	})
+
+	t.Run("Accessors", func(t *testing.T) {
+		l := NewLazy(func() Optional[int] { return Some(5) })
+		if l.OrElseGet(func() int { return 0 }) != 5 {
+			t.Error("OrElseGet should return computed value")
+		}
+		got := 0
+		l.IfPresent(func(v int) { got = v })
+		if got != 5 {
+			t.Errorf("Expected IfPresent to receive 5, got %d", got)
+		}
+		if l.Filter(func(v int) bool { return v > 10 }).IsPresent() {
+			t.Error("Filter should drop value")
+		}
+	})
+}
+
+func TestLazyPanic(t *testing.T) {
+	calls := 0
+	l := NewLazy(func() Optional[int] {
+		calls++
+		panic("boom")
+	})
+
+	for range 2 {
+		if r := recoverPanic(func() { l.Optional() }); r != "boom" {
+			t.Errorf("Expected panic 'boom', got %v", r)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected supplier to run once, got %d", calls)
+	}
+
+	l.Reset()
+	recoverPanic(func() { l.Optional() })
+	if calls != 2 {
+		t.Errorf("Expected supplier to run again after Reset, got %d", calls)
+	}
+}
+
+func TestMapLazy(t *testing.T) {
+	calls := 0
+	base := NewLazy(func() Optional[int] {
+		calls++
+		return Some(21)
+	})
+	doubled := MapLazy(base, func(x int) int { return x * 2 })
+	if calls != 0 {
+		t.Error("MapLazy should not force the base Lazy")
+	}
+	if doubled.Get() != 42 || doubled.Get() != 42 {
+		t.Errorf("Expected 42, got %v", doubled.Get())
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+
+	flat := FlatMapLazy(base, func(x int) Optional[string] { return None[string]() })
+	if flat.IsPresent() {
+		t.Error("FlatMapLazy should produce None")
+	}
+}
+
+func TestLazyConcurrentReset(t *testing.T) {
+	var calls atomic.Int32
+	l := NewLazy(func() Optional[int] {
+		return Some(int(calls.Add(1)))
+	})
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if l.Get() < 1 {
+					t.Error("Expected a computed value")
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				l.Reset()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLazyConcurrent(t *testing.T) {
+	var calls atomic.Int32
+	l := NewLazy(func() Optional[int] {
+		calls.Add(1)
+		return Some(7)
+	})
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if l.Get() != 7 {
+				t.Error("Expected 7")
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("Expected supplier to run once, got %d", calls.Load())
+	}
+}
+
+func BenchmarkLazy(b *testing.B) {
+	l := NewLazy(func() Optional[int] { return Some(42) })
+	l.Get()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = l.OrElse(0)
+	}
+}
+
+func BenchmarkLazyParallel(b *testing.B) {
+	l := NewLazy(func() Optional[int] { return Some(42) })
+	l.Get()
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = l.OrElse(0)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package optional
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Provenance records, for each field set by MergeWithProvenance, the index of
+// the layer its final value came from. Keys are field paths such as "DB.Host".
+type Provenance map[string]int
+
+// Source returns the index of the layer that supplied the field at path,
+// or None if no layer set it.
+func (p Provenance) Source(path string) Optional[int] {
+	if layer, ok := p[path]; ok {
+		return Some(layer)
+	}
+	return None[int]()
+}
+
+// Paths returns the field paths in sorted order.
+func (p Provenance) Paths() []string {
+	paths := make([]string, 0, len(p))
+	for path := range p {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// Merge combines layers of a struct type into one value, with later layers taking precedence.
+//
+// An Optional field takes the value of the last layer in which it is present.
+// Nested struct fields, and non-nil pointers to structs, are merged recursively when
+// the struct has exported fields and does not decode itself through encoding.TextUnmarshaler,
+// encoding.BinaryUnmarshaler or json.Unmarshaler. Such opaque structs, like time.Time
+// or Field[T], are treated as single values.
+// Any other field takes the value of the last layer in which it is not the zero value.
+// T must be a struct type; Merge panics otherwise.
+func Merge[T any](layers ...T) T {
+	result, _ := MergeWithProvenance(layers...)
+	return result
+}
+
+// MergeWithProvenance is like Merge but also reports which layer each final value came from.
+func MergeWithProvenance[T any](layers ...T) (T, Provenance) {
+	var result T
+	rv := reflect.ValueOf(&result).Elem()
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("optional: Merge requires a struct type, got %s", rv.Type()))
+	}
+
+	prov := Provenance{}
+	for i := range layers {
+		mergeStruct(rv, reflect.ValueOf(&layers[i]).Elem(), "", i, prov)
+	}
+	return result, prov
+}
+
+var (
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+)
+
+// isMergeable reports whether t is a struct to be merged field by field
+// rather than copied as a whole.
+func isMergeable(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	pt := reflect.PointerTo(t)
+	if pt.Implements(textUnmarshalerType) || pt.Implements(binaryUnmarshalerType) || pt.Implements(jsonUnmarshalerType) {
+		return false
+	}
+	for i := range t.NumField() {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeStruct merges src onto dst, recording the layer of every field it sets.
+func mergeStruct(dst, src reflect.Value, path string, layer int, prov Provenance) {
+	t := dst.Type()
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		fieldPath := path + f.Name
+		df, sf := dst.Field(i), src.Field(i)
+
+		switch {
+		case isOptionalType(f.Type):
+			if _, present := sf.Interface().(reflectOptional).reflectGet(); present {
+				df.Set(sf)
+				prov[fieldPath] = layer
+			}
+		case isMergeable(f.Type):
+			mergeStruct(df, sf, fieldPath+".", layer, prov)
+		case f.Type.Kind() == reflect.Pointer && isMergeable(f.Type.Elem()):
+			if sf.IsNil() {
+				continue
+			}
+			if df.IsNil() {
+				df.Set(reflect.New(f.Type.Elem()))
+			}
+			mergeStruct(df.Elem(), sf.Elem(), fieldPath+".", layer, prov)
+		default:
+			if !sf.IsZero() {
+				df.Set(sf)
+				prov[fieldPath] = layer
+			}
+		}
+	}
+}
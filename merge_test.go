@@ -0,0 +1,161 @@
+package optional
+
+import (
+	"maps"
+	"slices"
+	"testing"
+	"time"
+)
+
+type dbSettings struct {
+	Host Optional[string]
+	Port Optional[int]
+}
+
+type settings struct {
+	Name    Optional[string]
+	Debug   Optional[bool]
+	Timeout Optional[time.Duration]
+	Workers int
+	DB      dbSettings
+	Cache   *dbSettings
+	hidden  Optional[string]
+}
+
+func TestMerge(t *testing.T) {
+	defaults := settings{
+		Name:    Some("app"),
+		Debug:   Some(true),
+		Timeout: Some(time.Second),
+		Workers: 2,
+		DB:      dbSettings{Host: Some("localhost"), Port: Some(5432)},
+	}
+	file := settings{
+		Debug: Some(false),
+		DB:    dbSettings{Host: Some("db.internal")},
+		Cache: &dbSettings{Port: Some(6379)},
+	}
+	env := settings{
+		Workers: 8,
+		DB:      dbSettings{Port: Some(6543)},
+		hidden:  Some("x"),
+	}
+	flags := settings{
+		Name: Some("cli"),
+	}
+
+	t.Run("Later Some values override", func(t *testing.T) {
+		got := Merge(defaults, file, env, flags)
+		if got.Name.OrElse("") != "cli" {
+			t.Errorf("Expected Name Some(cli), got %v", got.Name)
+		}
+		if !Equal(got.Debug, Some(false)) {
+			t.Errorf("Expected Debug Some(false), got %v", got.Debug)
+		}
+		if got.Timeout.OrElse(0) != time.Second {
+			t.Errorf("Expected Timeout Some(1s), got %v", got.Timeout)
+		}
+		if got.Workers != 8 {
+			t.Errorf("Expected Workers 8, got %d", got.Workers)
+		}
+		if got.DB.Host.OrElse("") != "db.internal" || got.DB.Port.OrElse(0) != 6543 {
+			t.Errorf("Unexpected DB: %v", got.DB)
+		}
+		if got.Cache == nil || got.Cache.Port.OrElse(0) != 6379 || got.Cache.Host.IsPresent() {
+			t.Errorf("Unexpected Cache: %+v", got.Cache)
+		}
+		if got.hidden.IsPresent() {
+			t.Error("Unexported fields should not be merged")
+		}
+	})
+
+	t.Run("Does not alias layer pointers", func(t *testing.T) {
+		got := Merge(defaults, file)
+		got.Cache.Port = Some(1)
+		if file.Cache.Port.OrElse(0) != 6379 {
+			t.Error("Merge should not modify layers")
+		}
+	})
+
+	t.Run("No layers", func(t *testing.T) {
+		got := Merge[settings]()
+		if got.Name.IsPresent() || got.Cache != nil {
+			t.Errorf("Expected zero value, got %+v", got)
+		}
+	})
+
+	t.Run("Opaque structs are copied whole", func(t *testing.T) {
+		type schedule struct {
+			Start time.Time
+			End   *time.Time
+			Limit Field[int]
+		}
+		start := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+		end := start.Add(time.Hour)
+
+		got, prov := MergeWithProvenance(
+			schedule{Start: start, Limit: SetField(3)},
+			schedule{End: &end},
+			schedule{},
+		)
+		if !got.Start.Equal(start) {
+			t.Errorf("Expected start %v, got %v", start, got.Start)
+		}
+		if got.End == nil || !got.End.Equal(end) {
+			t.Errorf("Expected end %v, got %v", end, got.End)
+		}
+		if got.Limit.OrElse(0) != 3 {
+			t.Errorf("Expected limit 3, got %v", got.Limit)
+		}
+		want := Provenance{"Start": 0, "End": 1, "Limit": 0}
+		if !maps.Equal(prov, want) {
+			t.Errorf("Expected provenance %v, got %v", want, prov)
+		}
+
+		got = Merge(schedule{Limit: SetField(3)}, schedule{Limit: NullField[int]()})
+		if !got.Limit.IsNull() {
+			t.Errorf("Expected later null field to win, got %v", got.Limit)
+		}
+	})
+
+	t.Run("Non-struct type panics", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Merge of non-struct should panic")
+			}
+		}()
+		Merge(1, 2)
+	})
+}
+
+func TestMergeWithProvenance(t *testing.T) {
+	defaults := settings{Name: Some("app"), DB: dbSettings{Port: Some(5432)}}
+	file := settings{DB: dbSettings{Host: Some("db")}, Cache: &dbSettings{Host: Some("cache")}}
+	flags := settings{Name: Some("cli"), Workers: 3}
+
+	got, prov := MergeWithProvenance(defaults, file, flags)
+	if got.Name.OrElse("") != "cli" {
+		t.Errorf("Expected Name Some(cli), got %v", got.Name)
+	}
+
+	want := map[string]int{
+		"Name":       2,
+		"Workers":    2,
+		"DB.Host":    1,
+		"DB.Port":    0,
+		"Cache.Host": 1,
+	}
+	for path, layer := range want {
+		if src := prov.Source(path); !Equal(src, Some(layer)) {
+			t.Errorf("%s: expected layer %d, got %v", path, layer, src)
+		}
+	}
+	if src := prov.Source("Debug"); src.IsPresent() {
+		t.Errorf("Debug: expected None, got %v", src)
+	}
+
+	paths := prov.Paths()
+	if !slices.Equal(paths, []string{"Cache.Host", "DB.Host", "DB.Port", "Name", "Workers"}) {
+		t.Errorf("Unexpected paths: %v", paths)
+	}
+}
@@ -3,8 +3,10 @@
 package optional
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
+	"reflect"
 )
 
 // Optional represents a value that may or may not be present
@@ -32,9 +34,60 @@ func FromPointer[T any](ptr *T) Optional[T] {
 	return Some(*ptr)
 }
 
+// FromOk creates an Optional from the comma-ok idiom.
+// Returns Some containing the value if ok is true, otherwise None.
+func FromOk[T any](value T, ok bool) Optional[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// FromErr creates an Optional from a (value, error) pair, discarding the error.
+// Returns Some containing the value if err is nil, otherwise None.
+// Use FromPair to keep the error.
+func FromErr[T any](value T, err error) Optional[T] {
+	if err != nil {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// Lookup returns the value stored in m under key, or None if the key is absent.
+func Lookup[K comparable, V any](m map[K]V, key K) Optional[V] {
+	v, ok := m[key]
+	return FromOk(v, ok)
+}
+
+// Index returns the element of s at index i, or None if i is out of range.
+func Index[T any](s []T, i int) Optional[T] {
+	if i < 0 || i >= len(s) {
+		return None[T]()
+	}
+	return Some(s[i])
+}
+
+// TypeAssert returns v asserted to type T, or None if v does not hold a T.
+func TypeAssert[T any](v any) Optional[T] {
+	t, ok := v.(T)
+	return FromOk(t, ok)
+}
+
+// Recv receives from ch, blocking until a value is sent or ch is closed.
+// Returns None if ch is closed.
+func Recv[T any](ch <-chan T) Optional[T] {
+	v, ok := <-ch
+	return FromOk(v, ok)
+}
+
+// Unpack returns the value and whether it is present, in comma-ok form.
+func (o Optional[T]) Unpack() (T, bool) {
+	return o.value, o.present
+}
+
 // ToPointer converts the Optional to a pointer.
-// Returns nil if the Optional is empty, or a pointer to the value if present.
-func (o *Optional[T]) ToPointer() *T {
+// Returns nil if the Optional is empty, or a pointer to a copy of the value if present.
+func (o Optional[T]) ToPointer() *T {
 	if !o.present {
 		return nil
 	}
@@ -42,53 +95,92 @@ func (o *Optional[T]) ToPointer() *T {
 }
 
 // IsPresent returns true if the Optional contains a value
-func (o *Optional[T]) IsPresent() bool {
+func (o Optional[T]) IsPresent() bool {
 	return o.present
 }
 
 // IsEmpty returns true if the Optional is empty
-func (o *Optional[T]) IsEmpty() bool {
+func (o Optional[T]) IsEmpty() bool {
 	return !o.present
 }
 
-// Get returns the value, panics if empty
-func (o *Optional[T]) Get() T {
+// IsZero reports whether the Optional is empty.
+// encoding/json uses it for the omitzero tag option, so a None field tagged
+// `json:",omitzero"` is left out of the output while Some(zero value) is still written.
+func (o Optional[T]) IsZero() bool {
+	return !o.present
+}
+
+// Get returns the value, panics with a *EmptyError if empty
+func (o Optional[T]) Get() T {
 	if !o.present {
-		panic("called Get() on empty Optional")
+		panic(newEmptyError[T]("Get", "", 1))
 	}
 	return o.value
 }
 
 // Equals checks if this Optional is equal to another Optional.
-// Two Optionals are equal if they are both empty or contain equal values.
-func (o *Optional[T]) Equals(other Optional[T]) bool {
+// Two Optionals are equal if they are both empty or contain values that are
+// deeply equal according to reflect.DeepEqual.
+// Use Equal or EqualFunc to avoid reflection.
+func (o Optional[T]) Equals(other Optional[T]) bool {
 	if o.present != other.present {
 		return false
 	}
 	if !o.present {
 		return true
 	}
-	return fmt.Sprintf("%v", o.value) == fmt.Sprintf("%v", other.value)
+	return reflect.DeepEqual(o.value, other.value)
+}
+
+// Equal reports whether two Optionals are equal, comparing values with ==.
+func Equal[T comparable](a, b Optional[T]) bool {
+	return a.present == b.present && (!a.present || a.value == b.value)
+}
+
+// EqualFunc reports whether two Optionals are equal, comparing values with the given function.
+// The function is only called when both Optionals are present.
+func EqualFunc[T, U any](a Optional[T], b Optional[U], eq func(T, U) bool) bool {
+	if a.present != b.present {
+		return false
+	}
+	return !a.present || eq(a.value, b.value)
+}
+
+// Compare returns -1, 0 or +1 depending on whether a is less than, equal to or greater than b.
+// None is ordered before any Some, and present values are ordered by cmp.Compare,
+// so Compare can be passed directly to slices.SortFunc.
+func Compare[T cmp.Ordered](a, b Optional[T]) int {
+	switch {
+	case a.present && b.present:
+		return cmp.Compare(a.value, b.value)
+	case a.present:
+		return 1
+	case b.present:
+		return -1
+	}
+	return 0
 }
 
 // Or returns this Optional if it has a value, otherwise returns the other Optional.
-func (o *Optional[T]) Or(other Optional[T]) Optional[T] {
+func (o Optional[T]) Or(other Optional[T]) Optional[T] {
 	if o.present {
-		return *o
+		return o
 	}
 	return other
 }
 
-// OrElsePanic returns the contained value if present, otherwise panics with the given message.
-func (o *Optional[T]) OrElsePanic(message string) T {
+// OrElsePanic returns the contained value if present,
+// otherwise panics with a *EmptyError carrying the given message.
+func (o Optional[T]) OrElsePanic(message string) T {
 	if !o.present {
-		panic(message)
+		panic(newEmptyError[T]("OrElsePanic", message, 1))
 	}
 	return o.value
 }
 
 // OrElse returns the value or a default value if empty
-func (o *Optional[T]) OrElse(defaultValue T) T {
+func (o Optional[T]) OrElse(defaultValue T) T {
 	if o.present {
 		return o.value
 	}
@@ -96,7 +188,7 @@ func (o *Optional[T]) OrElse(defaultValue T) T {
 }
 
 // OrElseGet returns the value or calls a supplier function if empty
-func (o *Optional[T]) OrElseGet(supplier func() T) T {
+func (o Optional[T]) OrElseGet(supplier func() T) T {
 	if o.present {
 		return o.value
 	}
@@ -104,7 +196,7 @@ func (o *Optional[T]) OrElseGet(supplier func() T) T {
 }
 
 // IfPresent calls the consumer function if value is present
-func (o *Optional[T]) IfPresent(consumer func(T)) {
+func (o Optional[T]) IfPresent(consumer func(T)) {
 	if o.present {
 		consumer(o.value)
 	}
@@ -112,7 +204,7 @@ func (o *Optional[T]) IfPresent(consumer func(T)) {
 
 // IfPresentOrElse executes the given consumer function if value is present,
 // otherwise executes the runnable function.
-func (o *Optional[T]) IfPresentOrElse(consumer func(T), runnable func()) {
+func (o Optional[T]) IfPresentOrElse(consumer func(T), runnable func()) {
 	if o.present {
 		consumer(o.value)
 	} else {
@@ -146,15 +238,15 @@ func FlatMap[T, U any](opt Optional[T], mapper func(T) Optional[U]) Optional[U]
 }
 
 // Filter returns the Optional if the predicate is true, otherwise None
-func (o *Optional[T]) Filter(predicate func(T) bool) Optional[T] {
+func (o Optional[T]) Filter(predicate func(T) bool) Optional[T] {
 	if o.present && predicate(o.value) {
-		return *o
+		return o
 	}
 	return None[T]()
 }
 
 // String returns string representation
-func (o *Optional[T]) String() string {
+func (o Optional[T]) String() string {
 	if o.present {
 		return fmt.Sprintf("Some(%v)", o.value)
 	}
@@ -162,7 +254,7 @@ func (o *Optional[T]) String() string {
 }
 
 // MarshalJSON implements json.Marshaler
-func (o *Optional[T]) MarshalJSON() ([]byte, error) {
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
 	if o.present {
 		return json.Marshal(o.value)
 	}
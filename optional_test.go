@@ -3,6 +3,10 @@ package optional
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -83,6 +87,92 @@ func TestFromPointer(t *testing.T) {
 	})
 }
 
+func TestFromOk(t *testing.T) {
+	if opt := FromOk(42, true); !Equal(opt, Some(42)) {
+		t.Errorf("Expected Some(42), got %v", opt)
+	}
+	if opt := FromOk(42, false); opt.IsPresent() {
+		t.Errorf("Expected None, got %v", opt)
+	}
+}
+
+func TestFromErr(t *testing.T) {
+	if opt := FromErr(strconv.Atoi("42")); !Equal(opt, Some(42)) {
+		t.Errorf("Expected Some(42), got %v", opt)
+	}
+	if opt := FromErr(strconv.Atoi("x")); opt.IsPresent() {
+		t.Errorf("Expected None, got %v", opt)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	m := map[string]int{"zero": 0}
+	if opt := Lookup(m, "zero"); !Equal(opt, Some(0)) {
+		t.Errorf("Expected Some(0), got %v", opt)
+	}
+	if opt := Lookup(m, "missing"); opt.IsPresent() {
+		t.Errorf("Expected None, got %v", opt)
+	}
+	if opt := Lookup(map[string]int(nil), "x"); opt.IsPresent() {
+		t.Errorf("Expected None for nil map, got %v", opt)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	s := []string{"a", "b"}
+	tests := []struct {
+		i    int
+		want Optional[string]
+	}{
+		{0, Some("a")},
+		{1, Some("b")},
+		{2, None[string]()},
+		{-1, None[string]()},
+	}
+	for _, tt := range tests {
+		if got := Index(s, tt.i); got != tt.want {
+			t.Errorf("Index(%d): expected %v, got %v", tt.i, tt.want, got)
+		}
+	}
+}
+
+func TestTypeAssert(t *testing.T) {
+	var v any = 42
+	if opt := TypeAssert[int](v); !Equal(opt, Some(42)) {
+		t.Errorf("Expected Some(42), got %v", opt)
+	}
+	if opt := TypeAssert[string](v); opt.IsPresent() {
+		t.Errorf("Expected None, got %v", opt)
+	}
+	if opt := TypeAssert[fmt.Stringer](Some(1)); !opt.IsPresent() {
+		t.Error("Expected Optional to satisfy fmt.Stringer")
+	}
+	if opt := TypeAssert[error](nil); opt.IsPresent() {
+		t.Errorf("Expected None for nil, got %v", opt)
+	}
+}
+
+func TestRecv(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 0
+	if opt := Recv(ch); !Equal(opt, Some(0)) {
+		t.Errorf("Expected Some(0), got %v", opt)
+	}
+	close(ch)
+	if opt := Recv(ch); opt.IsPresent() {
+		t.Errorf("Expected None after close, got %v", opt)
+	}
+}
+
+func TestUnpack(t *testing.T) {
+	if v, ok := Some(42).Unpack(); v != 42 || !ok {
+		t.Errorf("Expected (42, true), got (%v, %v)", v, ok)
+	}
+	if v, ok := None[int]().Unpack(); v != 0 || ok {
+		t.Errorf("Expected (0, false), got (%v, %v)", v, ok)
+	}
+}
+
 func TestToPointer(t *testing.T) {
 	t.Run("ToPointer with present value", func(t *testing.T) {
 		opt := Some(42)
@@ -137,6 +227,47 @@ func TestIsEmpty(t *testing.T) {
 	})
 }
 
+func TestIsZero(t *testing.T) {
+	type payload struct {
+		Name  Optional[string] `json:"name,omitzero"`
+		Count Optional[int]    `json:"count,omitzero"`
+		Note  Optional[string] `json:"note"`
+	}
+
+	t.Run("IsZero with Some", func(t *testing.T) {
+		if Some(0).IsZero() {
+			t.Error("Some(0) should not be zero")
+		}
+	})
+
+	t.Run("IsZero with None", func(t *testing.T) {
+		var opt Optional[int]
+		if !opt.IsZero() || !None[int]().IsZero() {
+			t.Error("None should be zero")
+		}
+	})
+
+	t.Run("omitzero drops None fields", func(t *testing.T) {
+		data, err := json.Marshal(payload{})
+		if err != nil {
+			t.Errorf("Marshal error: %v", err)
+		}
+		if string(data) != `{"note":null}` {
+			t.Errorf("Unexpected JSON: %s", string(data))
+		}
+	})
+
+	t.Run("omitzero keeps Some zero value", func(t *testing.T) {
+		data, err := json.Marshal(payload{Name: Some(""), Count: Some(0)})
+		if err != nil {
+			t.Errorf("Marshal error: %v", err)
+		}
+		if string(data) != `{"name":"","count":0,"note":null}` {
+			t.Errorf("Unexpected JSON: %s", string(data))
+		}
+	})
+}
+
 // Test value retrieval
 func TestGet(t *testing.T) {
 	t.Run("Get from Some", func(t *testing.T) {
@@ -190,6 +321,140 @@ func TestEquals(t *testing.T) {
 			t.Error("Some and None should not be equal")
 		}
 	})
+
+	t.Run("Structs holding distinct pointers to equal values", func(t *testing.T) {
+		type node struct{ Next *int }
+		a, b := 1, 1
+		if !Some(node{&a}).Equals(Some(node{&b})) {
+			t.Error("Deeply equal structs should be equal")
+		}
+	})
+
+	t.Run("Pointers to different values with same printout", func(t *testing.T) {
+		type box struct{ V any }
+		if Some(&box{int8(1)}).Equals(Some(&box{int16(1)})) {
+			t.Error("Pointers to different values should not be equal")
+		}
+	})
+
+	t.Run("Interface values of different types", func(t *testing.T) {
+		if Some[any](1.0).Equals(Some[any](1)) {
+			t.Error("float64 and int should not be equal")
+		}
+	})
+
+	t.Run("Maps with same contents", func(t *testing.T) {
+		a := map[string]int{"x": 1, "y": 2, "z": 3}
+		b := map[string]int{"z": 3, "y": 2, "x": 1}
+		if !Some(a).Equals(Some(b)) {
+			t.Error("Maps with same contents should be equal")
+		}
+	})
+
+	t.Run("Slices", func(t *testing.T) {
+		if !Some([]int{1, 2}).Equals(Some([]int{1, 2})) {
+			t.Error("Equal slices should be equal")
+		}
+		if Some([]int{1, 2}).Equals(Some([]int{2, 1})) {
+			t.Error("Different slices should not be equal")
+		}
+	})
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Optional[int]
+		want bool
+	}{
+		{"Some equal", Some(1), Some(1), true},
+		{"Some different", Some(1), Some(2), false},
+		{"None equal", None[int](), None[int](), true},
+		{"Some vs None", Some(0), None[int](), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Equal(tt.a, tt.b); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("Pointers compare by identity", func(t *testing.T) {
+		a, b := 1, 1
+		if Equal(Some(&a), Some(&b)) || !Equal(Some(&a), Some(&a)) {
+			t.Error("Pointers should compare by identity")
+		}
+	})
+
+	t.Run("NaN is not equal to itself", func(t *testing.T) {
+		if Equal(Some(math.NaN()), Some(math.NaN())) {
+			t.Error("NaN should not be equal to NaN")
+		}
+	})
+}
+
+func TestEqualFunc(t *testing.T) {
+	called := false
+	eq := func(a string, b string) bool {
+		called = true
+		return strings.EqualFold(a, b)
+	}
+
+	if !EqualFunc(Some("Go"), Some("GO"), eq) {
+		t.Error("EqualFunc should use comparator")
+	}
+	called = false
+	if !EqualFunc(None[string](), None[string](), eq) || called {
+		t.Error("EqualFunc on None should not call comparator")
+	}
+	if EqualFunc(Some("a"), None[string](), eq) {
+		t.Error("Some and None should not be equal")
+	}
+
+	lenEq := func(s string, n int) bool { return len(s) == n }
+	if !EqualFunc(Some("abc"), Some(3), lenEq) {
+		t.Error("EqualFunc should support different types")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b Optional[int]
+		want int
+	}{
+		{None[int](), None[int](), 0},
+		{None[int](), Some(-5), -1},
+		{Some(-5), None[int](), 1},
+		{Some(1), Some(2), -1},
+		{Some(2), Some(2), 0},
+		{Some(3), Some(2), 1},
+	}
+	for _, tt := range tests {
+		if got := Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("Compare(%v, %v): expected %d, got %d", tt.a, tt.b, tt.want, got)
+		}
+	}
+
+	t.Run("SortFunc", func(t *testing.T) {
+		opts := []Optional[string]{Some("b"), None[string](), Some("a")}
+		slices.SortFunc(opts, Compare)
+		want := []Optional[string]{None[string](), Some("a"), Some("b")}
+		if !slices.Equal(opts, want) {
+			t.Errorf("Expected %v, got %v", want, opts)
+		}
+	})
+}
+
+func TestEqualAllocs(t *testing.T) {
+	x, y := Some(42), Some(42)
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = Equal(x, y)
+		_ = Compare(x, y)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected 0 allocations, got %v", allocs)
+	}
 }
 
 func TestOr(t *testing.T) {
@@ -241,10 +506,13 @@ func TestOrElsePanic(t *testing.T) {
 
 	t.Run("OrElsePanic with None should panic", func(t *testing.T) {
 		defer func() {
-			if r := recover(); r == nil {
+			r := recover()
+			if r == nil {
 				t.Error("OrElsePanic with None should panic")
-			} else if r != "custom panic message" {
-				t.Errorf("Expected custom panic message, got %v", r)
+			}
+			err, ok := r.(*EmptyError)
+			if !ok || err.Message != "custom panic message" {
+				t.Errorf("Expected EmptyError with custom panic message, got %v", r)
 			}
 		}()
 		opt := None[int]()
@@ -666,6 +934,116 @@ func TestUnmarshalJSON(t *testing.T) {
 	})
 }
 
+// Test value receivers
+func TestValueReceivers(t *testing.T) {
+	type holder struct {
+		Name Optional[string] `json:"name"`
+		Age  Optional[int]    `json:"age"`
+	}
+
+	t.Run("Marshal Optional by value", func(t *testing.T) {
+		data, err := json.Marshal(Some(42))
+		if err != nil {
+			t.Errorf("Marshal error: %v", err)
+		}
+		if string(data) != "42" {
+			t.Errorf("Expected '42', got %s", string(data))
+		}
+	})
+
+	t.Run("Marshal struct held by value", func(t *testing.T) {
+		data, err := json.Marshal(holder{Name: Some("bob"), Age: None[int]()})
+		if err != nil {
+			t.Errorf("Marshal error: %v", err)
+		}
+		if string(data) != `{"name":"bob","age":null}` {
+			t.Errorf("Unexpected JSON: %s", string(data))
+		}
+	})
+
+	t.Run("Marshal map values", func(t *testing.T) {
+		m := map[string]Optional[int]{"a": Some(1), "b": None[int]()}
+		data, err := json.Marshal(m)
+		if err != nil {
+			t.Errorf("Marshal error: %v", err)
+		}
+		if string(data) != `{"a":1,"b":null}` {
+			t.Errorf("Unexpected JSON: %s", string(data))
+		}
+	})
+
+	t.Run("Marshal slice elements", func(t *testing.T) {
+		data, err := json.Marshal([]Optional[string]{Some("x"), None[string]()})
+		if err != nil {
+			t.Errorf("Marshal error: %v", err)
+		}
+		if string(data) != `["x",null]` {
+			t.Errorf("Unexpected JSON: %s", string(data))
+		}
+	})
+
+	t.Run("Marshal through interface", func(t *testing.T) {
+		var v any = Some(3.5)
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Errorf("Marshal error: %v", err)
+		}
+		if string(data) != "3.5" {
+			t.Errorf("Expected '3.5', got %s", string(data))
+		}
+	})
+
+	t.Run("Print Optional by value", func(t *testing.T) {
+		if s := fmt.Sprint(Some(42)); s != "Some(42)" {
+			t.Errorf("Expected 'Some(42)', got %s", s)
+		}
+		if s := fmt.Sprintf("%v", None[int]()); s != "None" {
+			t.Errorf("Expected 'None', got %s", s)
+		}
+	})
+
+	t.Run("Print containers of Optionals", func(t *testing.T) {
+		if s := fmt.Sprint([]Optional[int]{Some(1), None[int]()}); s != "[Some(1) None]" {
+			t.Errorf("Unexpected output: %s", s)
+		}
+		if s := fmt.Sprint(map[string]Optional[int]{"k": Some(7)}); s != "map[k:Some(7)]" {
+			t.Errorf("Unexpected output: %s", s)
+		}
+		if s := fmt.Sprintf("%v", holder{Name: Some("bob")}); s != "{Some(bob) None}" {
+			t.Errorf("Unexpected output: %s", s)
+		}
+	})
+
+	t.Run("Call methods on map element", func(t *testing.T) {
+		m := map[string]Optional[int]{"a": Some(1)}
+		if !m["a"].IsPresent() || m["a"].Get() != 1 {
+			t.Error("Expected map element to be Some(1)")
+		}
+		if m["missing"].IsPresent() {
+			t.Error("Missing map element should be None")
+		}
+	})
+
+	t.Run("ToPointer does not alias", func(t *testing.T) {
+		opt := Some(42)
+		ptr := opt.ToPointer()
+		*ptr = 7
+		if opt.Get() != 42 {
+			t.Errorf("Expected 42, got %v", opt.Get())
+		}
+	})
+
+	t.Run("Unmarshal into struct field", func(t *testing.T) {
+		var h holder
+		if err := json.Unmarshal([]byte(`{"name":"amy","age":null}`), &h); err != nil {
+			t.Errorf("Unmarshal error: %v", err)
+		}
+		if h.Name.OrElse("") != "amy" || h.Age.IsPresent() {
+			t.Errorf("Unexpected result: %v", h)
+		}
+	})
+}
+
 // Integration tests
 func TestIntegration(t *testing.T) {
 	t.Run("Chain operations", func(t *testing.T) {
@@ -865,3 +1243,98 @@ func BenchmarkString(b *testing.B) {
 		}
 	})
 }
+
+// Bridging constructor benchmarks
+func BenchmarkBridges(b *testing.B) {
+	m := map[string]int{"a": 1}
+	s := []int{1, 2, 3}
+	var v any = 42
+	ch := make(chan int)
+	close(ch)
+
+	b.Run("FromOk", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = FromOk(i, i%2 == 0)
+		}
+	})
+
+	b.Run("FromErr", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = FromErr(i, nil)
+		}
+	})
+
+	b.Run("Lookup", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Lookup(m, "a")
+		}
+	})
+
+	b.Run("Index", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Index(s, i%4)
+		}
+	})
+
+	b.Run("TypeAssert", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = TypeAssert[int](v)
+		}
+	})
+
+	b.Run("Recv", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Recv(ch)
+		}
+	})
+
+	b.Run("Unpack", func(b *testing.B) {
+		opt := Some(42)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = opt.Unpack()
+		}
+	})
+}
+
+// Equality benchmarks
+func BenchmarkEqual(b *testing.B) {
+	b.Run("Equal", func(b *testing.B) {
+		x, y := Some(42), Some(42)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Equal(x, y)
+		}
+	})
+
+	b.Run("EqualFunc", func(b *testing.B) {
+		x, y := Some(42), Some(42)
+		eq := func(a, b int) bool { return a == b }
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = EqualFunc(x, y, eq)
+		}
+	})
+
+	b.Run("Compare", func(b *testing.B) {
+		x, y := Some(42), Some(43)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Compare(x, y)
+		}
+	})
+
+	b.Run("Equals", func(b *testing.B) {
+		x, y := Some(42), Some(42)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = x.Equals(y)
+		}
+	})
+}
@@ -0,0 +1,337 @@
+package optional
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/vuongnq9x/optional/internal/reflectopt"
+)
+
+// reflectOptional is implemented by every Optional[T] and lets reflection-based
+// helpers read and write Optionals without knowing T.
+type reflectOptional interface {
+	reflectGet() (reflect.Value, bool)
+	reflectElem() reflect.Type
+}
+
+// reflectOptionalSetter is implemented by every *Optional[T].
+type reflectOptionalSetter interface {
+	reflectSet(value reflect.Value)
+}
+
+var reflectOptionalSetterType = reflect.TypeFor[reflectOptionalSetter]()
+
+func (o Optional[T]) reflectGet() (reflect.Value, bool) {
+	return reflect.ValueOf(&o.value).Elem(), o.present
+}
+
+func (o Optional[T]) reflectElem() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (o *Optional[T]) reflectSet(value reflect.Value) {
+	reflect.ValueOf(&o.value).Elem().Set(value)
+	o.present = true
+}
+
+func init() {
+	reflectopt.IsOptional = isOptionalType
+	reflectopt.Elem = optionalElem
+	reflectopt.Set = func(dst, value reflect.Value) {
+		dst.Addr().Interface().(reflectOptionalSetter).reflectSet(value)
+	}
+}
+
+// isOptionalType reports whether t is an Optional[T] for some T.
+func isOptionalType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && reflect.PointerTo(t).Implements(reflectOptionalSetterType)
+}
+
+// optionalElem returns T for a type Optional[T].
+func optionalElem(t reflect.Type) reflect.Type {
+	return reflect.Zero(t).Interface().(reflectOptional).reflectElem()
+}
+
+// patchFieldName returns the destination field name for a patch field,
+// taken from the optional struct tag or the field name. It returns "" for skipped fields.
+func patchFieldName(f reflect.StructField) string {
+	if !f.IsExported() {
+		return ""
+	}
+	if tag, ok := f.Tag.Lookup("optional"); ok {
+		if tag == "-" {
+			return ""
+		}
+		if tag != "" {
+			return tag
+		}
+	}
+	return f.Name
+}
+
+// Apply copies every present value of a patch struct onto the matching fields of dst.
+//
+// dst must be a non-nil pointer to a struct. patch must be a struct, or a pointer
+// to one, whose fields are Optionals or nested patch structs. Fields are matched by
+// name, or by the name given in an `optional:"Name"` tag; a tag of "-" skips the field.
+// A present Optional[T] is assigned to a destination field of type T or Optional[T].
+// Nested patch structs are applied recursively, allocating nil destination pointers.
+// Promoted fields behind a nil embedded pointer allocate it as well; when the embedded
+// type is unexported and cannot be allocated, the field is reported as an error.
+//
+// All fields are validated before anything is written, so dst is left unchanged
+// when an error is returned. Every mismatch found is reported, joined with errors.Join.
+func Apply(dst any, patch any) error {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Pointer || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("optional: Apply destination must be a non-nil pointer to a struct, got %T", dst)
+	}
+	pv := reflect.ValueOf(patch)
+	if pv.Kind() == reflect.Pointer {
+		if pv.IsNil() {
+			return nil
+		}
+		pv = pv.Elem()
+	}
+	if pv.Kind() != reflect.Struct {
+		return fmt.Errorf("optional: Apply patch must be a struct, got %T", patch)
+	}
+
+	if err := applyStruct(dv.Elem(), pv, "", false); err != nil {
+		return err
+	}
+	return applyStruct(dv.Elem(), pv, "", true)
+}
+
+// applyStruct validates, or when write is set applies, patch onto dst.
+func applyStruct(dst, patch reflect.Value, path string, write bool) error {
+	var errs []error
+	pt := patch.Type()
+	for i := range pt.NumField() {
+		pf := pt.Field(i)
+		name := patchFieldName(pf)
+		if name == "" {
+			continue
+		}
+		fieldPath := path + name
+
+		df, ok := dst.Type().FieldByName(name)
+		if !ok || !df.IsExported() {
+			errs = append(errs, fmt.Errorf("optional: patch field %s has no matching field in %s", fieldPath, dst.Type()))
+			continue
+		}
+		pvField := patch.Field(i)
+
+		if isOptionalType(pf.Type) {
+			value, present := pvField.Interface().(reflectOptional).reflectGet()
+			if !present {
+				continue
+			}
+			dvField, err := embeddedField(dst, df.Index, write)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("optional: patch field %s: %w", fieldPath, err))
+				continue
+			}
+			if err := assignValue(dvField, value, fieldPath, write); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+
+		nested := pvField
+		if nested.Kind() == reflect.Pointer {
+			if nested.IsNil() {
+				continue
+			}
+			nested = nested.Elem()
+		}
+		if nested.Kind() != reflect.Struct {
+			errs = append(errs, fmt.Errorf("optional: patch field %s must be an Optional or a struct, got %s", fieldPath, pf.Type))
+			continue
+		}
+
+		dvField, err := embeddedField(dst, df.Index, write)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("optional: patch field %s: %w", fieldPath, err))
+			continue
+		}
+		target := dvField
+		if target.Kind() == reflect.Pointer {
+			if target.IsNil() {
+				if !write {
+					target = reflect.New(target.Type().Elem())
+				} else {
+					target.Set(reflect.New(target.Type().Elem()))
+				}
+			}
+			target = target.Elem()
+		}
+		if target.Kind() != reflect.Struct {
+			errs = append(errs, fmt.Errorf("optional: patch field %s is a struct but %s.%s is %s", fieldPath, dst.Type(), name, dvField.Type()))
+			continue
+		}
+		if err := applyStruct(target, nested, fieldPath+".", write); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// embeddedField returns the field of v at index, stepping through embedded pointers.
+// A nil embedded pointer is allocated when write is set; otherwise a zero value stands
+// in for it so the field can still be validated.
+func embeddedField(v reflect.Value, index []int, write bool) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, fmt.Errorf("cannot allocate nil embedded pointer to unexported %s", v.Type().Elem())
+				}
+				if write {
+					v.Set(reflect.New(v.Type().Elem()))
+				} else {
+					v = reflect.New(v.Type().Elem())
+				}
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, nil
+}
+
+// assignValue stores value in dst, wrapping it in Some when dst is an Optional.
+func assignValue(dst, value reflect.Value, path string, write bool) error {
+	switch {
+	case value.Type().AssignableTo(dst.Type()):
+		if write {
+			dst.Set(value)
+		}
+		return nil
+	case isOptionalType(dst.Type()) && value.Type().AssignableTo(optionalElem(dst.Type())):
+		if write {
+			dst.Addr().Interface().(reflectOptionalSetter).reflectSet(value)
+		}
+		return nil
+	}
+	return fmt.Errorf("optional: patch field %s of type %s cannot be assigned to %s", path, value.Type(), dst.Type())
+}
+
+// promotedField returns the field f of v, or its zero value when it is
+// promoted through a nil embedded pointer.
+func promotedField(v reflect.Value, f reflect.StructField) reflect.Value {
+	field, err := v.FieldByIndexErr(f.Index)
+	if err != nil {
+		return reflect.Zero(f.Type)
+	}
+	return field
+}
+
+// Diff returns a patch of type P that turns old into new when passed to Apply.
+//
+// P follows the same rules as the patch argument of Apply. Each Optional field of P
+// is set to Some of the new value when the matching field differs between old and new
+// according to reflect.DeepEqual, and left as None otherwise. A field promoted through
+// a nil embedded pointer compares as its zero value. Nested patch structs are
+// diffed recursively. When a source field is itself an Optional[T] and the patch field
+// is an Optional[T], a change to None cannot be expressed and is left out; use a patch
+// field of type Optional[Optional[T]] to carry it.
+func Diff[P, T any](old, new T) (P, error) {
+	var patch P
+	pv := reflect.ValueOf(&patch).Elem()
+	if pv.Kind() != reflect.Struct {
+		return patch, fmt.Errorf("optional: Diff patch must be a struct, got %s", pv.Type())
+	}
+	ov, nv := reflect.ValueOf(&old).Elem(), reflect.ValueOf(&new).Elem()
+	if ov.Kind() == reflect.Pointer {
+		if ov.IsNil() || nv.IsNil() {
+			return patch, errors.New("optional: Diff arguments must not be nil")
+		}
+		ov, nv = ov.Elem(), nv.Elem()
+	}
+	if ov.Kind() != reflect.Struct {
+		return patch, fmt.Errorf("optional: Diff arguments must be structs, got %s", ov.Type())
+	}
+	_, err := diffStruct(pv, ov, nv, "")
+	return patch, err
+}
+
+// diffStruct fills patch with the differences between old and new and
+// reports whether any field was set.
+func diffStruct(patch, old, new reflect.Value, path string) (bool, error) {
+	var errs []error
+	changed := false
+	pt := patch.Type()
+	for i := range pt.NumField() {
+		pf := pt.Field(i)
+		name := patchFieldName(pf)
+		if name == "" {
+			continue
+		}
+		fieldPath := path + name
+
+		sf, ok := old.Type().FieldByName(name)
+		if !ok || !sf.IsExported() {
+			errs = append(errs, fmt.Errorf("optional: patch field %s has no matching field in %s", fieldPath, old.Type()))
+			continue
+		}
+		of, nf := promotedField(old, sf), promotedField(new, sf)
+		pvField := patch.Field(i)
+
+		if isOptionalType(pf.Type) {
+			elem := optionalElem(pf.Type)
+			value := nf
+			if !value.Type().AssignableTo(elem) && isOptionalType(value.Type()) {
+				var present bool
+				value, present = value.Interface().(reflectOptional).reflectGet()
+				if !present {
+					continue
+				}
+			}
+			if !value.Type().AssignableTo(elem) {
+				errs = append(errs, fmt.Errorf("optional: field %s of type %s cannot be stored in patch field of type %s", fieldPath, sf.Type, pf.Type))
+				continue
+			}
+			if !reflect.DeepEqual(of.Interface(), nf.Interface()) {
+				pvField.Addr().Interface().(reflectOptionalSetter).reflectSet(value)
+				changed = true
+			}
+			continue
+		}
+
+		nested := pvField
+		if pf.Type.Kind() == reflect.Pointer && pf.Type.Elem().Kind() == reflect.Struct {
+			nested = reflect.New(pf.Type.Elem()).Elem()
+		}
+		if nested.Kind() != reflect.Struct {
+			errs = append(errs, fmt.Errorf("optional: patch field %s must be an Optional or a struct, got %s", fieldPath, pf.Type))
+			continue
+		}
+		if of.Kind() == reflect.Pointer {
+			if of.IsNil() {
+				of = reflect.New(of.Type().Elem())
+			}
+			if nf.IsNil() {
+				nf = reflect.New(nf.Type().Elem())
+			}
+			of, nf = of.Elem(), nf.Elem()
+		}
+		if of.Kind() != reflect.Struct {
+			errs = append(errs, fmt.Errorf("optional: patch field %s is a struct but %s.%s is %s", fieldPath, old.Type(), name, sf.Type))
+			continue
+		}
+		nestedChanged, err := diffStruct(nested, of, nf, fieldPath+".")
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if nestedChanged {
+			if pvField.Kind() == reflect.Pointer {
+				pvField.Set(nested.Addr())
+			}
+			changed = true
+		}
+	}
+	return changed, errors.Join(errs...)
+}
@@ -0,0 +1,267 @@
+package optional
+
+import (
+	"strings"
+	"testing"
+)
+
+type address struct {
+	City string
+	Zip  string
+}
+
+type user struct {
+	Name     string
+	Age      int
+	Nickname Optional[string]
+	Home     address
+	Work     *address
+	Tags     []string
+}
+
+// Audit is exported so that a nil *Audit embedded in a struct can be allocated through reflection.
+type Audit struct {
+	Version int
+	Editor  string
+}
+
+type auditedUser struct {
+	*Audit
+	*address
+	Name string
+}
+
+type addressPatch struct {
+	City Optional[string]
+	Zip  Optional[string]
+}
+
+type userPatch struct {
+	Name     Optional[string]
+	Years    Optional[int] `optional:"Age"`
+	Nickname Optional[string]
+	Home     addressPatch
+	Work     *addressPatch
+	Tags     Optional[[]string]
+	Ignored  Optional[bool] `optional:"-"`
+}
+
+func TestApply(t *testing.T) {
+	t.Run("Copies present values", func(t *testing.T) {
+		u := user{Name: "bob", Age: 30}
+		err := Apply(&u, userPatch{Name: Some("alice"), Years: Some(31)})
+		if err != nil {
+			t.Fatalf("Apply error: %v", err)
+		}
+		if u.Name != "alice" || u.Age != 31 {
+			t.Errorf("Unexpected result: %+v", u)
+		}
+	})
+
+	t.Run("Leaves None fields alone", func(t *testing.T) {
+		u := user{Name: "bob", Age: 30}
+		if err := Apply(&u, userPatch{}); err != nil {
+			t.Fatalf("Apply error: %v", err)
+		}
+		if u.Name != "bob" || u.Age != 30 {
+			t.Errorf("Unexpected result: %+v", u)
+		}
+	})
+
+	t.Run("Assigns into Optional destination", func(t *testing.T) {
+		var u user
+		if err := Apply(&u, &userPatch{Nickname: Some("bobby")}); err != nil {
+			t.Fatalf("Apply error: %v", err)
+		}
+		if u.Nickname.OrElse("") != "bobby" {
+			t.Errorf("Expected Some(bobby), got %v", u.Nickname)
+		}
+	})
+
+	t.Run("Recurses into nested patches", func(t *testing.T) {
+		u := user{Home: address{City: "Hanoi", Zip: "100000"}}
+		patch := userPatch{
+			Home: addressPatch{City: Some("Hue")},
+			Work: &addressPatch{Zip: Some("700000")},
+		}
+		if err := Apply(&u, patch); err != nil {
+			t.Fatalf("Apply error: %v", err)
+		}
+		if u.Home.City != "Hue" || u.Home.Zip != "100000" {
+			t.Errorf("Unexpected home: %+v", u.Home)
+		}
+		if u.Work == nil || u.Work.Zip != "700000" {
+			t.Errorf("Unexpected work: %+v", u.Work)
+		}
+	})
+
+	t.Run("Allocates nil embedded pointers", func(t *testing.T) {
+		type patch struct {
+			Version Optional[int]
+			Name    Optional[string]
+		}
+		var u auditedUser
+		if err := Apply(&u, patch{Version: Some(2)}); err != nil {
+			t.Fatalf("Apply error: %v", err)
+		}
+		if u.Audit == nil || u.Version != 2 {
+			t.Errorf("Unexpected result: %+v", u.Audit)
+		}
+
+		var untouched auditedUser
+		if err := Apply(&untouched, patch{Name: Some("bob")}); err != nil {
+			t.Fatalf("Apply error: %v", err)
+		}
+		if untouched.Audit != nil {
+			t.Errorf("Expected nil embedded pointer when no promoted field is set, got %+v", untouched.Audit)
+		}
+	})
+
+	t.Run("Reports nil unexported embedded pointers", func(t *testing.T) {
+		type patch struct {
+			City Optional[string]
+			Name Optional[string]
+		}
+		u := auditedUser{Name: "bob"}
+		err := Apply(&u, patch{City: Some("Hue"), Name: Some("alice")})
+		if err == nil || !strings.Contains(err.Error(), "City") {
+			t.Fatalf("Expected error for City, got %v", err)
+		}
+		if u.Name != "bob" {
+			t.Errorf("Destination should be unchanged, got %+v", u)
+		}
+	})
+
+	t.Run("Reports type mismatches without writing", func(t *testing.T) {
+		type badPatch struct {
+			Name Optional[string]
+			Age  Optional[string]
+			Home Optional[int]
+		}
+		u := user{Name: "bob"}
+		err := Apply(&u, badPatch{Name: Some("alice"), Age: Some("x"), Home: Some(1)})
+		if err == nil {
+			t.Fatal("Expected error for type mismatch")
+		}
+		if !strings.Contains(err.Error(), "Age") || !strings.Contains(err.Error(), "Home") {
+			t.Errorf("Expected both mismatches reported, got %v", err)
+		}
+		if u.Name != "bob" {
+			t.Errorf("Destination should be unchanged, got %+v", u)
+		}
+	})
+
+	t.Run("Reports unknown fields", func(t *testing.T) {
+		type unknownPatch struct {
+			Email Optional[string]
+		}
+		if err := Apply(&user{}, unknownPatch{Email: Some("x")}); err == nil {
+			t.Error("Expected error for unknown field")
+		}
+	})
+
+	t.Run("Rejects invalid arguments", func(t *testing.T) {
+		if err := Apply(user{}, userPatch{}); err == nil {
+			t.Error("Expected error for non-pointer destination")
+		}
+		if err := Apply(&user{}, 42); err == nil {
+			t.Error("Expected error for non-struct patch")
+		}
+	})
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("Produces patch of changed fields", func(t *testing.T) {
+		old := user{Name: "bob", Age: 30, Home: address{City: "Hanoi"}}
+		new := user{Name: "bob", Age: 31, Home: address{City: "Hue"}, Tags: []string{"a"}}
+		patch, err := Diff[userPatch](old, new)
+		if err != nil {
+			t.Fatalf("Diff error: %v", err)
+		}
+		if patch.Name.IsPresent() {
+			t.Errorf("Unchanged field should be None, got %v", patch.Name)
+		}
+		if patch.Years.OrElse(0) != 31 {
+			t.Errorf("Expected Some(31), got %v", patch.Years)
+		}
+		if patch.Home.City.OrElse("") != "Hue" || patch.Home.Zip.IsPresent() {
+			t.Errorf("Unexpected nested patch: %+v", patch.Home)
+		}
+		if patch.Work != nil {
+			t.Errorf("Unchanged nested pointer should stay nil, got %+v", patch.Work)
+		}
+		if len(patch.Tags.OrElse(nil)) != 1 {
+			t.Errorf("Expected Some([a]), got %v", patch.Tags)
+		}
+	})
+
+	t.Run("Roundtrip with Apply", func(t *testing.T) {
+		old := user{Name: "bob", Nickname: Some("b"), Work: &address{City: "Hanoi"}}
+		new := user{Name: "alice", Nickname: Some("al"), Work: &address{City: "Hue", Zip: "1"}}
+		patch, err := Diff[userPatch](old, new)
+		if err != nil {
+			t.Fatalf("Diff error: %v", err)
+		}
+		if err := Apply(&old, patch); err != nil {
+			t.Fatalf("Apply error: %v", err)
+		}
+		if old.Name != "alice" || old.Nickname.OrElse("") != "al" || *old.Work != *new.Work {
+			t.Errorf("Expected %+v, got %+v", new, old)
+		}
+	})
+
+	t.Run("Clearing an Optional needs a nested Optional", func(t *testing.T) {
+		type clearPatch struct {
+			Nickname Optional[Optional[string]]
+		}
+		patch, err := Diff[clearPatch](user{Nickname: Some("b")}, user{})
+		if err != nil {
+			t.Fatalf("Diff error: %v", err)
+		}
+		if !patch.Nickname.IsPresent() || patch.Nickname.Get().IsPresent() {
+			t.Errorf("Expected Some(None), got %v", patch.Nickname)
+		}
+
+		u := user{Nickname: Some("b")}
+		if err := Apply(&u, patch); err != nil {
+			t.Fatalf("Apply error: %v", err)
+		}
+		if u.Nickname.IsPresent() {
+			t.Errorf("Expected None, got %v", u.Nickname)
+		}
+	})
+
+	t.Run("Works with pointers", func(t *testing.T) {
+		patch, err := Diff[userPatch](&user{Age: 1}, &user{Age: 2})
+		if err != nil {
+			t.Fatalf("Diff error: %v", err)
+		}
+		if patch.Years.OrElse(0) != 2 {
+			t.Errorf("Expected Some(2), got %v", patch.Years)
+		}
+	})
+
+	t.Run("Treats nil embedded pointers as zero", func(t *testing.T) {
+		type patch struct {
+			City Optional[string]
+			Zip  Optional[string]
+		}
+		type located struct{ *address }
+		got, err := Diff[patch](located{}, located{&address{City: "Hue"}})
+		if err != nil {
+			t.Fatalf("Diff error: %v", err)
+		}
+		if !Equal(got.City, Some("Hue")) || got.Zip.IsPresent() {
+			t.Errorf("Unexpected patch: %+v", got)
+		}
+	})
+
+	t.Run("Reports type mismatches", func(t *testing.T) {
+		type badPatch struct {
+			Age Optional[string]
+		}
+		if _, err := Diff[badPatch](user{}, user{Age: 1}); err == nil {
+			t.Error("Expected error for type mismatch")
+		}
+	})
+}
@@ -0,0 +1,173 @@
+package optional
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Result represents either a value or an error
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok creates a successful Result with a value
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Err creates a failed Result with an error.
+// A nil error yields a successful Result holding the zero value.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// FromPair creates a Result from the common (value, error) return pair.
+// Returns Err if err is non-nil, otherwise Ok containing the value.
+func FromPair[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(value)
+}
+
+// OkOr converts an Optional to a Result.
+// Returns Ok containing the value if present, otherwise Err with the given error.
+// If err is nil, an empty Optional yields Err with a *EmptyError matching ErrEmpty,
+// so it never becomes a success.
+func OkOr[T any](opt Optional[T], err error) Result[T] {
+	if opt.present {
+		return Ok(opt.value)
+	}
+	if err == nil {
+		err = &EmptyError{Op: "OkOr", Type: reflect.TypeFor[T]().String()}
+	}
+	return Err[T](err)
+}
+
+// IsOk returns true if the Result contains a value
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the Result contains an error
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Err returns the error, or nil if the Result is Ok.
+// The returned error is the one passed in, so errors.Is and errors.As work on it directly.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Get returns the value and the error as a pair
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Unwrap returns the value, panics if the Result is Err.
+// The panic value is an error wrapping the original error.
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Errorf("called Unwrap() on Err Result: %w", r.err))
+	}
+	return r.value
+}
+
+// Optional converts the Result to an Optional, discarding the error.
+func (r Result[T]) Optional() Optional[T] {
+	if r.err != nil {
+		return None[T]()
+	}
+	return Some(r.value)
+}
+
+// Or returns this Result if it is Ok, otherwise returns the other Result.
+func (r Result[T]) Or(other Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return other
+}
+
+// OrElse returns the value or a default value if the Result is Err
+func (r Result[T]) OrElse(defaultValue T) T {
+	if r.err == nil {
+		return r.value
+	}
+	return defaultValue
+}
+
+// OrElseGet returns the value or calls a supplier function with the error if the Result is Err
+func (r Result[T]) OrElseGet(supplier func(error) T) T {
+	if r.err == nil {
+		return r.value
+	}
+	return supplier(r.err)
+}
+
+// MapResult transforms the Result value if Ok
+func MapResult[T, U any](r Result[T], mapper func(T) U) Result[U] {
+	if r.err == nil {
+		return Ok(mapper(r.value))
+	}
+	return Err[U](r.err)
+}
+
+// FlatMapResult transforms the Result value to another Result if Ok
+func FlatMapResult[T, U any](r Result[T], mapper func(T) Result[U]) Result[U] {
+	if r.err == nil {
+		return mapper(r.value)
+	}
+	return Err[U](r.err)
+}
+
+// MapErr transforms the Result error if Err
+func (r Result[T]) MapErr(mapper func(error) error) Result[T] {
+	if r.err != nil {
+		return Err[T](mapper(r.err))
+	}
+	return r
+}
+
+// String returns string representation
+func (r Result[T]) String() string {
+	if r.err == nil {
+		return fmt.Sprintf("Ok(%v)", r.value)
+	}
+	return fmt.Sprintf("Err(%v)", r.err)
+}
+
+// MarshalJSON implements json.Marshaler.
+// Ok is written as {"value":...} and Err as {"error":"message"}.
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.err != nil {
+		return json.Marshal(struct {
+			Error string `json:"error"`
+		}{r.err.Error()})
+	}
+	return json.Marshal(struct {
+		Value T `json:"value"`
+	}{r.value})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// A decoded error only keeps its message; the original error type is not restored.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Value T       `json:"value"`
+		Error *string `json:"error"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Error != nil {
+		*r = Err[T](errors.New(*raw.Error))
+		return nil
+	}
+	*r = Ok(raw.Value)
+	return nil
+}
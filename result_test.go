@@ -0,0 +1,194 @@
+package optional
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"strconv"
+	"testing"
+)
+
+var errTest = errors.New("test error")
+
+func TestResultConstructors(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		r := Ok(42)
+		if !r.IsOk() || r.IsErr() {
+			t.Error("Ok should be ok")
+		}
+		if r.Unwrap() != 42 {
+			t.Errorf("Expected 42, got %v", r.Unwrap())
+		}
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		r := Err[int](errTest)
+		if r.IsOk() || !r.IsErr() {
+			t.Error("Err should be err")
+		}
+		if r.Err() != errTest {
+			t.Errorf("Expected errTest, got %v", r.Err())
+		}
+	})
+
+	t.Run("FromPair", func(t *testing.T) {
+		if r := FromPair(strconv.Atoi("42")); r.OrElse(0) != 42 {
+			t.Errorf("Expected Ok(42), got %v", r)
+		}
+		if r := FromPair(strconv.Atoi("x")); !r.IsErr() {
+			t.Errorf("Expected Err, got %v", r)
+		}
+	})
+
+	t.Run("OkOr", func(t *testing.T) {
+		if r := OkOr(Some(1), errTest); r.OrElse(0) != 1 {
+			t.Errorf("Expected Ok(1), got %v", r)
+		}
+		if r := OkOr(None[int](), errTest); r.Err() != errTest {
+			t.Errorf("Expected Err(errTest), got %v", r)
+		}
+	})
+
+	t.Run("OkOr with nil error", func(t *testing.T) {
+		if r := OkOr(None[int](), nil); r.IsOk() || !errors.Is(r.Err(), ErrEmpty) {
+			t.Errorf("Expected Err(ErrEmpty), got %v", r)
+		}
+		if r := None[int]().OkOr(nil); r.IsOk() || !errors.Is(r.Err(), ErrEmpty) {
+			t.Errorf("Expected Err(ErrEmpty), got %v", r)
+		}
+		if r := OkOr(Some(1), nil); r.OrElse(0) != 1 {
+			t.Errorf("Expected Ok(1), got %v", r)
+		}
+	})
+}
+
+func TestResultUnwrap(t *testing.T) {
+	t.Run("Unwrap Err panics with wrapped error", func(t *testing.T) {
+		defer func() {
+			r := recover()
+			err, ok := r.(error)
+			if !ok || !errors.Is(err, errTest) {
+				t.Errorf("Expected panic wrapping errTest, got %v", r)
+			}
+		}()
+		Err[int](errTest).Unwrap()
+	})
+
+	t.Run("errors.As on Err", func(t *testing.T) {
+		r := Err[int](&fs.PathError{Op: "open", Path: "x", Err: fs.ErrNotExist})
+		var pathErr *fs.PathError
+		if !errors.As(r.Err(), &pathErr) || pathErr.Path != "x" {
+			t.Error("errors.As should find the PathError")
+		}
+		if !errors.Is(r.Err(), fs.ErrNotExist) {
+			t.Error("errors.Is should find fs.ErrNotExist")
+		}
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		v, err := Ok(1).Get()
+		if v != 1 || err != nil {
+			t.Errorf("Expected (1, nil), got (%v, %v)", v, err)
+		}
+		_, err = Err[int](errTest).Get()
+		if err != errTest {
+			t.Errorf("Expected errTest, got %v", err)
+		}
+	})
+}
+
+func TestResultCombinators(t *testing.T) {
+	t.Run("MapResult", func(t *testing.T) {
+		if r := MapResult(Ok(2), strconv.Itoa); r.OrElse("") != "2" {
+			t.Errorf("Expected Ok(2), got %v", r)
+		}
+		if r := MapResult(Err[int](errTest), strconv.Itoa); r.Err() != errTest {
+			t.Errorf("Expected Err(errTest), got %v", r)
+		}
+	})
+
+	t.Run("FlatMapResult", func(t *testing.T) {
+		parse := func(s string) Result[int] { return FromPair(strconv.Atoi(s)) }
+		if r := FlatMapResult(Ok("7"), parse); r.OrElse(0) != 7 {
+			t.Errorf("Expected Ok(7), got %v", r)
+		}
+		if r := FlatMapResult(Ok("x"), parse); !r.IsErr() {
+			t.Errorf("Expected Err, got %v", r)
+		}
+		if r := FlatMapResult(Err[string](errTest), parse); r.Err() != errTest {
+			t.Errorf("Expected Err(errTest), got %v", r)
+		}
+	})
+
+	t.Run("MapErr", func(t *testing.T) {
+		wrapped := errors.New("wrapped")
+		if r := Err[int](errTest).MapErr(func(error) error { return wrapped }); r.Err() != wrapped {
+			t.Errorf("Expected Err(wrapped), got %v", r)
+		}
+	})
+
+	t.Run("Or and OrElse", func(t *testing.T) {
+		if r := Err[int](errTest).Or(Ok(3)); r.OrElse(0) != 3 {
+			t.Errorf("Expected Ok(3), got %v", r)
+		}
+		if v := Err[int](errTest).OrElseGet(func(error) int { return 5 }); v != 5 {
+			t.Errorf("Expected 5, got %v", v)
+		}
+	})
+
+	t.Run("Optional", func(t *testing.T) {
+		if opt := Ok(1).Optional(); opt.OrElse(0) != 1 {
+			t.Errorf("Expected Some(1), got %v", opt)
+		}
+		if opt := Err[int](errTest).Optional(); opt.IsPresent() {
+			t.Errorf("Expected None, got %v", opt)
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if s := Ok(1).String(); s != "Ok(1)" {
+			t.Errorf("Expected 'Ok(1)', got %s", s)
+		}
+		if s := Err[int](errTest).String(); s != "Err(test error)" {
+			t.Errorf("Expected 'Err(test error)', got %s", s)
+		}
+	})
+}
+
+func TestResultJSON(t *testing.T) {
+	t.Run("Marshal Ok", func(t *testing.T) {
+		data, err := json.Marshal(Ok(0))
+		if err != nil {
+			t.Errorf("Marshal error: %v", err)
+		}
+		if string(data) != `{"value":0}` {
+			t.Errorf("Unexpected JSON: %s", string(data))
+		}
+	})
+
+	t.Run("Marshal Err", func(t *testing.T) {
+		data, err := json.Marshal(Err[int](errTest))
+		if err != nil {
+			t.Errorf("Marshal error: %v", err)
+		}
+		if string(data) != `{"error":"test error"}` {
+			t.Errorf("Unexpected JSON: %s", string(data))
+		}
+	})
+
+	t.Run("Roundtrip", func(t *testing.T) {
+		var ok, bad Result[string]
+		if err := json.Unmarshal([]byte(`{"value":"x"}`), &ok); err != nil {
+			t.Errorf("Unmarshal error: %v", err)
+		}
+		if ok.OrElse("") != "x" {
+			t.Errorf("Expected Ok(x), got %v", ok)
+		}
+		if err := json.Unmarshal([]byte(`{"error":"boom"}`), &bad); err != nil {
+			t.Errorf("Unmarshal error: %v", err)
+		}
+		if !bad.IsErr() || bad.Err().Error() != "boom" {
+			t.Errorf("Expected Err(boom), got %v", bad)
+		}
+	})
+}
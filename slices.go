@@ -0,0 +1,88 @@
+package optional
+
+// Sequence turns a slice of Optionals into an Optional slice.
+// Returns Some containing every value, in order, if all Optionals are present,
+// otherwise None. Nothing is allocated when the result is None.
+func Sequence[T any](opts []Optional[T]) Optional[[]T] {
+	for _, opt := range opts {
+		if !opt.present {
+			return None[[]T]()
+		}
+	}
+	values := make([]T, len(opts))
+	for i, opt := range opts {
+		values[i] = opt.value
+	}
+	return Some(values)
+}
+
+// Traverse applies mapper to every element and collects the results.
+// Returns None as soon as mapper returns None, without calling it for the remaining elements.
+func Traverse[T, U any](s []T, mapper func(T) Optional[U]) Optional[[]U] {
+	values := make([]U, len(s))
+	for i, v := range s {
+		opt := mapper(v)
+		if !opt.present {
+			return None[[]U]()
+		}
+		values[i] = opt.value
+	}
+	return Some(values)
+}
+
+// Compact returns the values of the present Optionals, in order.
+// It returns nil if none are present.
+func Compact[T any](opts []Optional[T]) []T {
+	n := CountPresent(opts)
+	if n == 0 {
+		return nil
+	}
+	values := make([]T, 0, n)
+	for _, opt := range opts {
+		if opt.present {
+			values = append(values, opt.value)
+		}
+	}
+	return values
+}
+
+// Partition splits a slice of Optionals into the values of the present ones
+// and the indices of the empty ones, both in order.
+func Partition[T any](opts []Optional[T]) (values []T, noneIndices []int) {
+	n := CountPresent(opts)
+	if n > 0 {
+		values = make([]T, 0, n)
+	}
+	if n < len(opts) {
+		noneIndices = make([]int, 0, len(opts)-n)
+	}
+	for i, opt := range opts {
+		if opt.present {
+			values = append(values, opt.value)
+		} else {
+			noneIndices = append(noneIndices, i)
+		}
+	}
+	return values, noneIndices
+}
+
+// CountPresent returns the number of present Optionals.
+func CountPresent[T any](opts []Optional[T]) int {
+	n := 0
+	for _, opt := range opts {
+		if opt.present {
+			n++
+		}
+	}
+	return n
+}
+
+// FirstSome returns the first present Optional, or None if none are present.
+func FirstSome[T any](opts ...Optional[T]) Optional[T] {
+	for _, opt := range opts {
+		if opt.present {
+			return opt
+		}
+	}
+	return None[T]()
+}
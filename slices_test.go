@@ -0,0 +1,186 @@
+package optional
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func parseInt(s string) Optional[int] {
+	return FromErr(strconv.Atoi(s))
+}
+
+func TestSequence(t *testing.T) {
+	t.Run("All present", func(t *testing.T) {
+		got := Sequence([]Optional[int]{Some(1), Some(2), Some(3)})
+		if !slices.Equal(got.OrElse(nil), []int{1, 2, 3}) {
+			t.Errorf("Expected Some([1 2 3]), got %v", got)
+		}
+	})
+
+	t.Run("Some None", func(t *testing.T) {
+		got := Sequence([]Optional[int]{Some(1), None[int](), Some(3)})
+		if got.IsPresent() {
+			t.Errorf("Expected None, got %v", got)
+		}
+	})
+
+	t.Run("Empty input", func(t *testing.T) {
+		got := Sequence([]Optional[int]{})
+		if !got.IsPresent() || len(got.Get()) != 0 {
+			t.Errorf("Expected Some([]), got %v", got)
+		}
+	})
+}
+
+func TestTraverse(t *testing.T) {
+	t.Run("All succeed", func(t *testing.T) {
+		got := Traverse([]string{"1", "2"}, parseInt)
+		if !slices.Equal(got.OrElse(nil), []int{1, 2}) {
+			t.Errorf("Expected Some([1 2]), got %v", got)
+		}
+	})
+
+	t.Run("Stops at first None", func(t *testing.T) {
+		calls := 0
+		got := Traverse([]string{"1", "x", "3"}, func(s string) Optional[int] {
+			calls++
+			return parseInt(s)
+		})
+		if got.IsPresent() {
+			t.Errorf("Expected None, got %v", got)
+		}
+		if calls != 2 {
+			t.Errorf("Expected 2 calls, got %d", calls)
+		}
+	})
+}
+
+func TestCompact(t *testing.T) {
+	got := Compact([]Optional[string]{None[string](), Some("a"), None[string](), Some("")})
+	if !slices.Equal(got, []string{"a", ""}) {
+		t.Errorf("Expected [a ], got %q", got)
+	}
+	if got := Compact([]Optional[string]{None[string]()}); got != nil {
+		t.Errorf("Expected nil, got %v", got)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	values, nones := Partition([]Optional[int]{Some(1), None[int](), Some(3), None[int]()})
+	if !slices.Equal(values, []int{1, 3}) {
+		t.Errorf("Expected values [1 3], got %v", values)
+	}
+	if !slices.Equal(nones, []int{1, 3}) {
+		t.Errorf("Expected indices [1 3], got %v", nones)
+	}
+
+	values, nones = Partition([]Optional[int]{Some(1)})
+	if len(values) != 1 || nones != nil {
+		t.Errorf("Expected ([1], nil), got (%v, %v)", values, nones)
+	}
+}
+
+func TestCountPresent(t *testing.T) {
+	if n := CountPresent([]Optional[int]{Some(1), None[int](), Some(0)}); n != 2 {
+		t.Errorf("Expected 2, got %d", n)
+	}
+	if n := CountPresent[int](nil); n != 0 {
+		t.Errorf("Expected 0, got %d", n)
+	}
+}
+
+func TestFirstSome(t *testing.T) {
+	if opt := FirstSome(None[int](), Some(2), Some(3)); !Equal(opt, Some(2)) {
+		t.Errorf("Expected Some(2), got %v", opt)
+	}
+	if opt := FirstSome(None[int](), None[int]()); opt.IsPresent() {
+		t.Errorf("Expected None, got %v", opt)
+	}
+	if opt := FirstSome[int](); opt.IsPresent() {
+		t.Errorf("Expected None, got %v", opt)
+	}
+}
+
+func TestSliceAllocs(t *testing.T) {
+	withNone := []Optional[int]{Some(1), None[int](), Some(3)}
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = Sequence(withNone)
+		_ = CountPresent(withNone)
+		_ = FirstSome(withNone...)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected 0 allocations, got %v", allocs)
+	}
+}
+
+func benchmarkOptionals(n int, everyNone int) []Optional[int] {
+	opts := make([]Optional[int], n)
+	for i := range opts {
+		if everyNone > 0 && i%everyNone == 0 {
+			opts[i] = None[int]()
+		} else {
+			opts[i] = Some(i)
+		}
+	}
+	return opts
+}
+
+func BenchmarkSequence(b *testing.B) {
+	b.Run("AllPresent", func(b *testing.B) {
+		opts := benchmarkOptionals(1000, 0)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Sequence(opts)
+		}
+	})
+
+	b.Run("WithNone", func(b *testing.B) {
+		opts := benchmarkOptionals(1000, 500)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Sequence(opts)
+		}
+	})
+}
+
+func BenchmarkTraverse(b *testing.B) {
+	s := make([]int, 1000)
+	double := func(x int) Optional[int] { return Some(x * 2) }
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Traverse(s, double)
+	}
+}
+
+func BenchmarkCompact(b *testing.B) {
+	opts := benchmarkOptionals(1000, 3)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Compact(opts)
+	}
+}
+
+func BenchmarkPartition(b *testing.B) {
+	opts := benchmarkOptionals(1000, 3)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = Partition(opts)
+	}
+}
+
+func BenchmarkCountPresent(b *testing.B) {
+	opts := benchmarkOptionals(1000, 3)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = CountPresent(opts)
+	}
+}
+
+func BenchmarkFirstSome(b *testing.B) {
+	a, c := None[int](), Some(2)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = FirstSome(a, a, c)
+	}
+}
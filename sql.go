@@ -0,0 +1,43 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// Scan implements sql.Scanner.
+// SQL NULL becomes None; any other driver value is converted into T using
+// the same rules as database/sql applies when scanning into a *T.
+func (o *Optional[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*o = FromSQLNull(n)
+	return nil
+}
+
+// Value implements driver.Valuer.
+// None is written as SQL NULL. A present value is converted by
+// driver.DefaultParameterConverter, which calls its Value method when T implements
+// driver.Valuer and maps a nil pointer to NULL.
+func (o Optional[T]) Value() (driver.Value, error) {
+	if !o.present {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.value)
+}
+
+// FromSQLNull creates an Optional from a sql.Null.
+// Returns None if the sql.Null is not valid.
+func FromSQLNull[T any](n sql.Null[T]) Optional[T] {
+	if !n.Valid {
+		return None[T]()
+	}
+	return Some(n.V)
+}
+
+// ToSQLNull converts the Optional to a sql.Null.
+func (o Optional[T]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{V: o.value, Valid: o.present}
+}
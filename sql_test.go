@@ -0,0 +1,271 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeDriver is a minimal in-memory database/sql driver.
+// Every Exec appends its arguments as a row; every Query returns all stored rows.
+type fakeDriver struct {
+	mu   sync.Mutex
+	rows [][]driver.Value
+}
+
+type fakeConn struct{ d *fakeDriver }
+
+type fakeStmt struct {
+	d     *fakeDriver
+	query string
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+	pos  int
+}
+
+var testDriver = &fakeDriver{}
+
+func init() {
+	sql.Register("optionalfake", testDriver)
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{d: d}, nil }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{d: c.d, query: query}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("transactions not supported") }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	if s.query == "DELETE" {
+		s.d.rows = nil
+		return driver.RowsAffected(0), nil
+	}
+	s.d.rows = append(s.d.rows, append([]driver.Value(nil), args...))
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	return &fakeRows{rows: s.d.rows}, nil
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return []string{"v"}
+	}
+	return make([]string, len(r.rows[0]))
+}
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.pos])
+	r.pos++
+	return nil
+}
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("optionalfake", "")
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	if _, err := db.Exec("DELETE"); err != nil {
+		t.Fatalf("Exec error: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestScan(t *testing.T) {
+	t.Run("Scan NULL", func(t *testing.T) {
+		opt := Some(42)
+		if err := opt.Scan(nil); err != nil {
+			t.Errorf("Scan error: %v", err)
+		}
+		if opt.IsPresent() {
+			t.Error("Scanned NULL should not be present")
+		}
+	})
+
+	t.Run("Scan int64 into int", func(t *testing.T) {
+		var opt Optional[int]
+		if err := opt.Scan(int64(42)); err != nil {
+			t.Errorf("Scan error: %v", err)
+		}
+		if opt.OrElse(0) != 42 {
+			t.Errorf("Expected Some(42), got %v", opt)
+		}
+	})
+
+	t.Run("Scan bytes into string", func(t *testing.T) {
+		var opt Optional[string]
+		if err := opt.Scan([]byte("hello")); err != nil {
+			t.Errorf("Scan error: %v", err)
+		}
+		if opt.OrElse("") != "hello" {
+			t.Errorf("Expected Some(hello), got %v", opt)
+		}
+	})
+
+	t.Run("Scan string into float64", func(t *testing.T) {
+		var opt Optional[float64]
+		if err := opt.Scan("2.5"); err != nil {
+			t.Errorf("Scan error: %v", err)
+		}
+		if opt.OrElse(0) != 2.5 {
+			t.Errorf("Expected Some(2.5), got %v", opt)
+		}
+	})
+
+	t.Run("Scan int64 into bool", func(t *testing.T) {
+		var opt Optional[bool]
+		if err := opt.Scan(int64(1)); err != nil {
+			t.Errorf("Scan error: %v", err)
+		}
+		if !opt.OrElse(false) {
+			t.Errorf("Expected Some(true), got %v", opt)
+		}
+	})
+
+	t.Run("Scan time.Time", func(t *testing.T) {
+		now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		var opt Optional[time.Time]
+		if err := opt.Scan(now); err != nil {
+			t.Errorf("Scan error: %v", err)
+		}
+		if !opt.OrElse(time.Time{}).Equal(now) {
+			t.Errorf("Expected Some(%v), got %v", now, opt)
+		}
+	})
+
+	t.Run("Scan invalid conversion", func(t *testing.T) {
+		var opt Optional[int]
+		if err := opt.Scan("not a number"); err == nil {
+			t.Error("Should return error for invalid conversion")
+		}
+	})
+}
+
+func TestValue(t *testing.T) {
+	t.Run("Value of None", func(t *testing.T) {
+		v, err := None[int]().Value()
+		if err != nil {
+			t.Errorf("Value error: %v", err)
+		}
+		if v != nil {
+			t.Errorf("Expected nil, got %v", v)
+		}
+	})
+
+	t.Run("Value of Some int", func(t *testing.T) {
+		v, err := Some(42).Value()
+		if err != nil {
+			t.Errorf("Value error: %v", err)
+		}
+		if v != int64(42) {
+			t.Errorf("Expected int64(42), got %#v", v)
+		}
+	})
+
+	t.Run("Value of Some Valuer", func(t *testing.T) {
+		v, err := Some(sql.NullString{String: "x", Valid: true}).Value()
+		if err != nil {
+			t.Errorf("Value error: %v", err)
+		}
+		if v != "x" {
+			t.Errorf("Expected 'x', got %#v", v)
+		}
+	})
+
+	t.Run("Value of Some nil Valuer pointer", func(t *testing.T) {
+		v, err := Some((*sql.NullString)(nil)).Value()
+		if err != nil {
+			t.Errorf("Value error: %v", err)
+		}
+		if v != nil {
+			t.Errorf("Expected nil, got %#v", v)
+		}
+	})
+
+	t.Run("Value of unsupported type", func(t *testing.T) {
+		if _, err := Some(struct{}{}).Value(); err == nil {
+			t.Error("Should return error for unsupported type")
+		}
+	})
+}
+
+func TestSQLNull(t *testing.T) {
+	t.Run("FromSQLNull valid", func(t *testing.T) {
+		opt := FromSQLNull(sql.Null[int]{V: 42, Valid: true})
+		if opt.OrElse(0) != 42 {
+			t.Errorf("Expected Some(42), got %v", opt)
+		}
+	})
+
+	t.Run("FromSQLNull invalid", func(t *testing.T) {
+		opt := FromSQLNull(sql.Null[int]{V: 42})
+		if opt.IsPresent() {
+			t.Error("Invalid sql.Null should not be present")
+		}
+	})
+
+	t.Run("ToSQLNull", func(t *testing.T) {
+		if n := Some(42).ToSQLNull(); !n.Valid || n.V != 42 {
+			t.Errorf("Expected valid 42, got %v", n)
+		}
+		if n := None[int]().ToSQLNull(); n.Valid {
+			t.Errorf("Expected invalid, got %v", n)
+		}
+	})
+}
+
+func TestSQLRoundtrip(t *testing.T) {
+	db := openTestDB(t)
+
+	if _, err := db.Exec("INSERT", Some(int64(7)), Some("a")); err != nil {
+		t.Fatalf("Exec error: %v", err)
+	}
+	if _, err := db.Exec("INSERT", None[int64](), None[string]()); err != nil {
+		t.Fatalf("Exec error: %v", err)
+	}
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatalf("Query error: %v", err)
+	}
+	defer rows.Close()
+
+	var got []string
+	for rows.Next() {
+		var n Optional[int]
+		var s Optional[string]
+		if err := rows.Scan(&n, &s); err != nil {
+			t.Fatalf("Scan error: %v", err)
+		}
+		got = append(got, n.String()+","+s.String())
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("Rows error: %v", err)
+	}
+
+	if len(got) != 2 || got[0] != "Some(7),Some(a)" || got[1] != "None,None" {
+		t.Errorf("Unexpected rows: %v", got)
+	}
+}
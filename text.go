@@ -0,0 +1,164 @@
+package optional
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// MarshalText implements encoding.TextMarshaler.
+// None is written as the empty string. A present value uses its own MarshalText method
+// when T implements encoding.TextMarshaler, time.Duration.String for durations,
+// and strconv formatting for strings, booleans and numbers otherwise. Some("") of a string Optional therefore does not
+// survive a text roundtrip; use MarshalTextWith or NullText when that matters.
+func (o Optional[T]) MarshalText() ([]byte, error) {
+	return o.MarshalTextWith("")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The empty string becomes None; any other text is parsed into T using *T's UnmarshalText
+// method when available, time.ParseDuration for durations, and strconv parsing for
+// strings, booleans and numbers otherwise.
+func (o *Optional[T]) UnmarshalText(text []byte) error {
+	return o.UnmarshalTextWith(text, "")
+}
+
+// MarshalTextWith is like MarshalText but writes None as the given empty representation.
+func (o Optional[T]) MarshalTextWith(empty string) ([]byte, error) {
+	if !o.present {
+		return []byte(empty), nil
+	}
+	return formatText(o.value)
+}
+
+// UnmarshalTextWith is like UnmarshalText but reads the given empty representation as None.
+func (o *Optional[T]) UnmarshalTextWith(text []byte, empty string) error {
+	if string(text) == empty {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if err := parseText(&value, string(text)); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+// NullText is an Optional whose text form writes None as "null" instead of the
+// empty string, so that Some("") survives a roundtrip. As with Nillable, the
+// representation is chosen by the field type, which keeps it usable for map keys
+// and flags. All other behaviour is that of the embedded Optional.
+type NullText[T any] struct {
+	Optional[T]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (n NullText[T]) MarshalText() ([]byte, error) {
+	return n.MarshalTextWith("null")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (n *NullText[T]) UnmarshalText(text []byte) error {
+	return n.UnmarshalTextWith(text, "null")
+}
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// formatText converts a value to text through encoding.TextMarshaler,
+// time.Duration.String or strconv.
+// A non-nil pointer is formatted as the value it points to; a nil pointer is an error.
+func formatText(value any) ([]byte, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil, fmt.Errorf("optional: cannot marshal nil %T as text", value)
+	}
+	if m, ok := value.(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+
+	if v.Type() == durationType {
+		return []byte(time.Duration(v.Int()).String()), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		return formatText(v.Elem().Interface())
+	case reflect.String:
+		return []byte(v.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+	return nil, fmt.Errorf("optional: cannot marshal %T as text", value)
+}
+
+// parseText parses text into *ptr through encoding.TextUnmarshaler,
+// time.ParseDuration or strconv.
+// When *ptr is itself a pointer, a new value is allocated and parsed into.
+func parseText(ptr any, text string) error {
+	if u, ok := ptr.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(text))
+	}
+
+	v := reflect.ValueOf(ptr).Elem()
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(text)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(v.Type().Elem())
+		if err := parseText(elem.Interface(), text); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	case reflect.String:
+		v.SetString(text)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+		return nil
+	}
+	return fmt.Errorf("optional: cannot unmarshal text into %s", v.Type())
+}
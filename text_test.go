@@ -0,0 +1,238 @@
+package optional
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func testTextRoundtrip[T any](t *testing.T, opt Optional[T], want string) {
+	t.Helper()
+	data, err := opt.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText error: %v", err)
+	}
+	if string(data) != want {
+		t.Errorf("Expected %q, got %q", want, string(data))
+	}
+
+	var restored Optional[T]
+	if err := restored.UnmarshalText(data); err != nil {
+		t.Fatalf("UnmarshalText error: %v", err)
+	}
+	if !restored.Equals(opt) {
+		t.Errorf("Roundtrip failed: expected %v, got %v", opt, restored)
+	}
+}
+
+func TestMarshalText(t *testing.T) {
+	t.Run("int", func(t *testing.T) { testTextRoundtrip(t, Some(-42), "-42") })
+	t.Run("uint8", func(t *testing.T) { testTextRoundtrip(t, Some(uint8(255)), "255") })
+	t.Run("float64", func(t *testing.T) { testTextRoundtrip(t, Some(2.5), "2.5") })
+	t.Run("float32", func(t *testing.T) { testTextRoundtrip(t, Some(float32(0.1)), "0.1") })
+	t.Run("bool", func(t *testing.T) { testTextRoundtrip(t, Some(true), "true") })
+	t.Run("string", func(t *testing.T) { testTextRoundtrip(t, Some("hello"), "hello") })
+	t.Run("None", func(t *testing.T) { testTextRoundtrip(t, None[int](), "") })
+
+	t.Run("named type", func(t *testing.T) {
+		type port uint16
+		testTextRoundtrip(t, Some(port(8080)), "8080")
+	})
+
+	t.Run("time.Time", func(t *testing.T) {
+		ts := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+		testTextRoundtrip(t, Some(ts), "2024-05-06T07:08:09Z")
+	})
+
+	t.Run("net.IP", func(t *testing.T) {
+		testTextRoundtrip(t, Some(net.ParseIP("192.0.2.1")), "192.0.2.1")
+	})
+
+	t.Run("netip.Addr", func(t *testing.T) {
+		testTextRoundtrip(t, Some(netip.MustParseAddr("2001:db8::1")), "2001:db8::1")
+	})
+
+	t.Run("netip.Prefix", func(t *testing.T) {
+		testTextRoundtrip(t, Some(netip.MustParsePrefix("10.0.0.0/8")), "10.0.0.0/8")
+	})
+
+	t.Run("time.Duration", func(t *testing.T) {
+		testTextRoundtrip(t, Some(1500*time.Millisecond), "1.5s")
+	})
+
+	t.Run("*netip.Addr", func(t *testing.T) {
+		addr := netip.MustParseAddr("192.0.2.1")
+		testTextRoundtrip(t, Some(&addr), "192.0.2.1")
+	})
+
+	t.Run("*int", func(t *testing.T) {
+		n := 7
+		testTextRoundtrip(t, Some(&n), "7")
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		if _, err := Some[*netip.Addr](nil).MarshalText(); err == nil {
+			t.Error("Should return error for nil pointer")
+		}
+	})
+
+	t.Run("Unsupported type", func(t *testing.T) {
+		if _, err := Some([]int{1}).MarshalText(); err == nil {
+			t.Error("Should return error for unsupported type")
+		}
+	})
+}
+
+func TestUnmarshalText(t *testing.T) {
+	t.Run("Invalid int", func(t *testing.T) {
+		var opt Optional[int]
+		if err := opt.UnmarshalText([]byte("abc")); err == nil {
+			t.Error("Should return error for invalid int")
+		}
+	})
+
+	t.Run("Out of range", func(t *testing.T) {
+		var opt Optional[int8]
+		if err := opt.UnmarshalText([]byte("300")); err == nil {
+			t.Error("Should return error for out of range value")
+		}
+	})
+
+	t.Run("Invalid address", func(t *testing.T) {
+		var opt Optional[netip.Addr]
+		if err := opt.UnmarshalText([]byte("not-an-ip")); err == nil {
+			t.Error("Should return error for invalid address")
+		}
+	})
+
+	t.Run("Empty text resets to None", func(t *testing.T) {
+		opt := Some(1)
+		if err := opt.UnmarshalText(nil); err != nil {
+			t.Errorf("UnmarshalText error: %v", err)
+		}
+		if opt.IsPresent() {
+			t.Error("Empty text should produce None")
+		}
+	})
+}
+
+func TestMarshalTextWith(t *testing.T) {
+	t.Run("None uses marker", func(t *testing.T) {
+		data, err := None[string]().MarshalTextWith("-")
+		if err != nil {
+			t.Fatalf("MarshalTextWith error: %v", err)
+		}
+		if string(data) != "-" {
+			t.Errorf("Expected '-', got %q", string(data))
+		}
+	})
+
+	t.Run("Empty string survives", func(t *testing.T) {
+		data, err := Some("").MarshalTextWith("-")
+		if err != nil {
+			t.Fatalf("MarshalTextWith error: %v", err)
+		}
+		var restored Optional[string]
+		if err := restored.UnmarshalTextWith(data, "-"); err != nil {
+			t.Fatalf("UnmarshalTextWith error: %v", err)
+		}
+		if !Equal(restored, Some("")) {
+			t.Errorf("Expected Some(\"\"), got %v", restored)
+		}
+	})
+
+	t.Run("Marker becomes None", func(t *testing.T) {
+		opt := Some(1)
+		if err := opt.UnmarshalTextWith([]byte("-"), "-"); err != nil {
+			t.Fatalf("UnmarshalTextWith error: %v", err)
+		}
+		if opt.IsPresent() {
+			t.Errorf("Expected None, got %v", opt)
+		}
+	})
+}
+
+func TestNullText(t *testing.T) {
+	t.Run("Roundtrip", func(t *testing.T) {
+		for _, opt := range []Optional[string]{Some(""), Some("a"), None[string]()} {
+			data, err := NullText[string]{opt}.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText error: %v", err)
+			}
+			var restored NullText[string]
+			if err := restored.UnmarshalText(data); err != nil {
+				t.Fatalf("UnmarshalText error: %v", err)
+			}
+			if !Equal(restored.Optional, opt) {
+				t.Errorf("Roundtrip failed: expected %v, got %v", opt, restored.Optional)
+			}
+		}
+	})
+
+	t.Run("JSON map keys", func(t *testing.T) {
+		m := map[NullText[string]]int{{Some("")}: 1, {None[string]()}: 2}
+		data, err := json.Marshal(m)
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		if string(data) != `{"":1,"null":2}` {
+			t.Errorf("Unexpected JSON: %s", string(data))
+		}
+	})
+}
+
+func TestTextIntegration(t *testing.T) {
+	t.Run("JSON map keys", func(t *testing.T) {
+		m := map[Optional[int]]string{Some(1): "one", None[int](): "none"}
+		data, err := json.Marshal(m)
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		if string(data) != `{"":"none","1":"one"}` {
+			t.Errorf("Unexpected JSON: %s", string(data))
+		}
+
+		var restored map[Optional[int]]string
+		if err := json.Unmarshal(data, &restored); err != nil {
+			t.Fatalf("Unmarshal error: %v", err)
+		}
+		if restored[Some(1)] != "one" || restored[None[int]()] != "none" {
+			t.Errorf("Unexpected map: %v", restored)
+		}
+	})
+
+	t.Run("JSON values still use MarshalJSON", func(t *testing.T) {
+		data, err := json.Marshal(Some(1))
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		if string(data) != "1" {
+			t.Errorf("Expected '1', got %s", string(data))
+		}
+	})
+
+	t.Run("XML attribute", func(t *testing.T) {
+		type server struct {
+			XMLName xml.Name             `xml:"server"`
+			Addr    Optional[netip.Addr] `xml:"addr,attr"`
+		}
+		data, err := xml.Marshal(server{Addr: Some(netip.MustParseAddr("192.0.2.1"))})
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		if string(data) != `<server addr="192.0.2.1"></server>` {
+			t.Errorf("Unexpected XML: %s", string(data))
+		}
+
+		var restored server
+		if err := xml.Unmarshal(data, &restored); err != nil {
+			t.Fatalf("Unmarshal error: %v", err)
+		}
+		if restored.Addr.OrElse(netip.Addr{}).String() != "192.0.2.1" {
+			t.Errorf("Unexpected result: %v", restored.Addr)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package optional
+
+import "encoding/xml"
+
+// xsiNamespace is the XML Schema instance namespace that defines the nil attribute.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// MarshalXML implements xml.Marshaler.
+// None omits the element entirely; a present value is encoded as the element's content.
+func (o Optional[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !o.present {
+		return nil
+	}
+	return e.EncodeElement(o.value, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element carrying xsi:nil="true" becomes None; any other element is decoded into T.
+// A missing element leaves the Optional untouched, so it stays None in a fresh struct.
+func (o *Optional[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if isXMLNil(start) {
+		*o = None[T]()
+		return d.Skip()
+	}
+
+	var value T
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// None omits the attribute; a present value is formatted like MarshalText.
+func (o Optional[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !o.present {
+		return xml.Attr{}, nil
+	}
+	text, err := formatText(o.value)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+// The attribute value is parsed like UnmarshalText, except that an empty value
+// is parsed into T rather than treated as None, since a missing attribute already means None.
+func (o *Optional[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var value T
+	if err := parseText(&value, attr.Value); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+func isXMLNil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" && (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") {
+			return attr.Value == "true" || attr.Value == "1"
+		}
+	}
+	return false
+}
+
+// Nillable is an Optional that writes None as an element with xsi:nil="true"
+// instead of omitting it. encoding/xml does not pass struct tag options to
+// marshalers, so the choice between the two forms is made by the field type.
+// All other behaviour, including decoding, is that of the embedded Optional.
+type Nillable[T any] struct {
+	Optional[T]
+}
+
+// MarshalXML implements xml.Marshaler.
+// None is written as an empty element with xsi:nil="true".
+func (n Nillable[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if n.present {
+		return e.EncodeElement(n.value, start)
+	}
+	start.Attr = append(start.Attr,
+		xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+		xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+	)
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
@@ -0,0 +1,139 @@
+package optional
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+type xmlAddress struct {
+	City Optional[string] `xml:"city"`
+	Zip  Optional[int]    `xml:"zip"`
+}
+
+type xmlPerson struct {
+	XMLName xml.Name             `xml:"person"`
+	ID      Optional[int]        `xml:"id,attr"`
+	Name    Optional[string]     `xml:"name"`
+	Age     Nillable[int]        `xml:"age"`
+	Address Optional[xmlAddress] `xml:"address"`
+	Scores  []Optional[int]      `xml:"score"`
+	Nested  Nillable[xmlAddress] `xml:"nested"`
+}
+
+func TestMarshalXML(t *testing.T) {
+	t.Run("Some values", func(t *testing.T) {
+		p := xmlPerson{
+			ID:      Some(7),
+			Name:    Some("bob"),
+			Age:     Nillable[int]{Some(30)},
+			Address: Some(xmlAddress{City: Some("Hue")}),
+			Scores:  []Optional[int]{Some(1), None[int](), Some(3)},
+			Nested:  Nillable[xmlAddress]{Some(xmlAddress{Zip: Some(1)})},
+		}
+		data, err := xml.Marshal(p)
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		want := `<person id="7"><name>bob</name><age>30</age><address><city>Hue</city></address>` +
+			`<score>1</score><score>3</score><nested><zip>1</zip></nested></person>`
+		if string(data) != want {
+			t.Errorf("Unexpected XML:\n got: %s\nwant: %s", string(data), want)
+		}
+	})
+
+	t.Run("None omits elements and attributes", func(t *testing.T) {
+		data, err := xml.Marshal(xmlPerson{})
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		want := `<person>` +
+			`<age xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></age>` +
+			`<nested xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></nested>` +
+			`</person>`
+		if string(data) != want {
+			t.Errorf("Unexpected XML:\n got: %s\nwant: %s", string(data), want)
+		}
+	})
+
+	t.Run("Top-level None", func(t *testing.T) {
+		data, err := xml.Marshal(None[int]())
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		if len(data) != 0 {
+			t.Errorf("Expected no output, got %s", string(data))
+		}
+	})
+}
+
+func TestUnmarshalXML(t *testing.T) {
+	t.Run("Missing elements are None", func(t *testing.T) {
+		var p xmlPerson
+		if err := xml.Unmarshal([]byte(`<person><name>amy</name></person>`), &p); err != nil {
+			t.Fatalf("Unmarshal error: %v", err)
+		}
+		if p.Name.OrElse("") != "amy" {
+			t.Errorf("Expected Some(amy), got %v", p.Name)
+		}
+		if p.ID.IsPresent() || p.Age.IsPresent() || p.Address.IsPresent() {
+			t.Errorf("Missing values should be None: %+v", p)
+		}
+	})
+
+	t.Run("xsi:nil elements are None", func(t *testing.T) {
+		input := `<person xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` +
+			`<name xsi:nil="true"/><age xsi:nil="true"></age></person>`
+		p := xmlPerson{Name: Some("x"), Age: Nillable[int]{Some(1)}}
+		if err := xml.Unmarshal([]byte(input), &p); err != nil {
+			t.Fatalf("Unmarshal error: %v", err)
+		}
+		if p.Name.IsPresent() || p.Age.IsPresent() {
+			t.Errorf("Nil elements should be None: %+v", p)
+		}
+	})
+
+	t.Run("Nested structs and slices", func(t *testing.T) {
+		input := `<person id="3"><address><zip>700000</zip></address>` +
+			`<score>5</score><score>6</score></person>`
+		var p xmlPerson
+		if err := xml.Unmarshal([]byte(input), &p); err != nil {
+			t.Fatalf("Unmarshal error: %v", err)
+		}
+		if p.ID.OrElse(0) != 3 {
+			t.Errorf("Expected id Some(3), got %v", p.ID)
+		}
+		addr := p.Address.OrElse(xmlAddress{})
+		if addr.City.IsPresent() || addr.Zip.OrElse(0) != 700000 {
+			t.Errorf("Unexpected address: %+v", addr)
+		}
+		if len(p.Scores) != 2 || p.Scores[0].OrElse(0) != 5 || p.Scores[1].OrElse(0) != 6 {
+			t.Errorf("Unexpected scores: %v", p.Scores)
+		}
+	})
+
+	t.Run("Roundtrip", func(t *testing.T) {
+		original := xmlPerson{ID: Some(1), Name: Some("bob"), Scores: []Optional[int]{Some(2)}}
+		data, err := xml.Marshal(original)
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		var restored xmlPerson
+		if err := xml.Unmarshal(data, &restored); err != nil {
+			t.Fatalf("Unmarshal error: %v", err)
+		}
+		if !restored.ID.Equals(original.ID) || !restored.Name.Equals(original.Name) ||
+			restored.Age.IsPresent() || len(restored.Scores) != 1 {
+			t.Errorf("Roundtrip failed: %+v", restored)
+		}
+	})
+
+	t.Run("Invalid content", func(t *testing.T) {
+		var p xmlPerson
+		if err := xml.Unmarshal([]byte(`<person><age>abc</age></person>`), &p); err == nil {
+			t.Error("Should return error for invalid content")
+		}
+		if err := xml.Unmarshal([]byte(`<person id="x"></person>`), &p); err == nil {
+			t.Error("Should return error for invalid attribute")
+		}
+	})
+}
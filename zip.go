@@ -0,0 +1,141 @@
+package optional
+
+// Pair holds two values
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple holds three values
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Quadruple holds four values
+type Quadruple[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// Quintuple holds five values
+type Quintuple[A, B, C, D, E any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+}
+
+// Sextuple holds six values
+type Sextuple[A, B, C, D, E, F any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+	Sixth  F
+}
+
+// Zip3 combines three Optionals using the provided combiner function.
+// Returns None if any Optional is empty.
+func Zip3[A, B, C, R any](a Optional[A], b Optional[B], c Optional[C], combiner func(A, B, C) R) Optional[R] {
+	if a.present && b.present && c.present {
+		return Some(combiner(a.value, b.value, c.value))
+	}
+	return None[R]()
+}
+
+// Zip4 combines four Optionals using the provided combiner function.
+// Returns None if any Optional is empty.
+func Zip4[A, B, C, D, R any](a Optional[A], b Optional[B], c Optional[C], d Optional[D], combiner func(A, B, C, D) R) Optional[R] {
+	if a.present && b.present && c.present && d.present {
+		return Some(combiner(a.value, b.value, c.value, d.value))
+	}
+	return None[R]()
+}
+
+// Zip5 combines five Optionals using the provided combiner function.
+// Returns None if any Optional is empty.
+func Zip5[A, B, C, D, E, R any](a Optional[A], b Optional[B], c Optional[C], d Optional[D], e Optional[E], combiner func(A, B, C, D, E) R) Optional[R] {
+	if a.present && b.present && c.present && d.present && e.present {
+		return Some(combiner(a.value, b.value, c.value, d.value, e.value))
+	}
+	return None[R]()
+}
+
+// Zip6 combines six Optionals using the provided combiner function.
+// Returns None if any Optional is empty.
+func Zip6[A, B, C, D, E, F, R any](a Optional[A], b Optional[B], c Optional[C], d Optional[D], e Optional[E], f Optional[F], combiner func(A, B, C, D, E, F) R) Optional[R] {
+	if a.present && b.present && c.present && d.present && e.present && f.present {
+		return Some(combiner(a.value, b.value, c.value, d.value, e.value, f.value))
+	}
+	return None[R]()
+}
+
+// ZipPair combines two Optionals into an Optional Pair.
+// Returns None if either Optional is empty.
+func ZipPair[A, B any](a Optional[A], b Optional[B]) Optional[Pair[A, B]] {
+	return Zip(a, b, func(a A, b B) Pair[A, B] {
+		return Pair[A, B]{a, b}
+	})
+}
+
+// ZipTriple combines three Optionals into an Optional Triple.
+// Returns None if any Optional is empty.
+func ZipTriple[A, B, C any](a Optional[A], b Optional[B], c Optional[C]) Optional[Triple[A, B, C]] {
+	return Zip3(a, b, c, func(a A, b B, c C) Triple[A, B, C] {
+		return Triple[A, B, C]{a, b, c}
+	})
+}
+
+// ZipQuadruple combines four Optionals into an Optional Quadruple.
+// Returns None if any Optional is empty.
+func ZipQuadruple[A, B, C, D any](a Optional[A], b Optional[B], c Optional[C], d Optional[D]) Optional[Quadruple[A, B, C, D]] {
+	return Zip4(a, b, c, d, func(a A, b B, c C, d D) Quadruple[A, B, C, D] {
+		return Quadruple[A, B, C, D]{a, b, c, d}
+	})
+}
+
+// ZipQuintuple combines five Optionals into an Optional Quintuple.
+// Returns None if any Optional is empty.
+func ZipQuintuple[A, B, C, D, E any](a Optional[A], b Optional[B], c Optional[C], d Optional[D], e Optional[E]) Optional[Quintuple[A, B, C, D, E]] {
+	return Zip5(a, b, c, d, e, func(a A, b B, c C, d D, e E) Quintuple[A, B, C, D, E] {
+		return Quintuple[A, B, C, D, E]{a, b, c, d, e}
+	})
+}
+
+// ZipSextuple combines six Optionals into an Optional Sextuple.
+// Returns None if any Optional is empty.
+func ZipSextuple[A, B, C, D, E, F any](a Optional[A], b Optional[B], c Optional[C], d Optional[D], e Optional[E], f Optional[F]) Optional[Sextuple[A, B, C, D, E, F]] {
+	return Zip6(a, b, c, d, e, f, func(a A, b B, c C, d D, e E, f F) Sextuple[A, B, C, D, E, F] {
+		return Sextuple[A, B, C, D, E, F]{a, b, c, d, e, f}
+	})
+}
+
+// Unzip splits an Optional Pair into two Optionals.
+// Returns two Nones if the Optional is empty.
+func Unzip[A, B any](opt Optional[Pair[A, B]]) (Optional[A], Optional[B]) {
+	if !opt.present {
+		return None[A](), None[B]()
+	}
+	return Some(opt.value.First), Some(opt.value.Second)
+}
+
+// Unzip3 splits an Optional Triple into three Optionals.
+// Returns three Nones if the Optional is empty.
+func Unzip3[A, B, C any](opt Optional[Triple[A, B, C]]) (Optional[A], Optional[B], Optional[C]) {
+	if !opt.present {
+		return None[A](), None[B](), None[C]()
+	}
+	return Some(opt.value.First), Some(opt.value.Second), Some(opt.value.Third)
+}
+
+// ZipWith combines any number of Optionals of the same type using the provided combiner,
+// which receives the values in order. Returns None if any Optional is empty.
+func ZipWith[T, R any](opts []Optional[T], combiner func([]T) R) Optional[R] {
+	return Map(Sequence(opts), combiner)
+}
@@ -0,0 +1,111 @@
+package optional
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestZipN(t *testing.T) {
+	a, b, c, d, e, f := Some(1), Some("x"), Some(2.5), Some(true), Some('r'), Some(uint(6))
+
+	t.Run("Zip3", func(t *testing.T) {
+		got := Zip3(a, b, c, func(a int, b string, c float64) string { return fmt.Sprint(a, b, c) })
+		if !Equal(got, Some("1x2.5")) {
+			t.Errorf("Expected Some(1x2.5), got %v", got)
+		}
+		if Zip3(a, None[string](), c, func(int, string, float64) int { return 0 }).IsPresent() {
+			t.Error("Zip3 with None should be None")
+		}
+	})
+
+	t.Run("Zip4", func(t *testing.T) {
+		got := Zip4(a, b, c, d, func(a int, b string, c float64, d bool) string { return fmt.Sprint(a, b, c, d) })
+		if !Equal(got, Some("1x2.5 true")) {
+			t.Errorf("Expected Some(1x2.5 true), got %v", got)
+		}
+		if Zip4(a, b, c, None[bool](), func(int, string, float64, bool) int { return 0 }).IsPresent() {
+			t.Error("Zip4 with None should be None")
+		}
+	})
+
+	t.Run("Zip5", func(t *testing.T) {
+		got := Zip5(a, b, c, d, e, func(a int, b string, c float64, d bool, e rune) int { return a + int(e) })
+		if !Equal(got, Some(1+int('r'))) {
+			t.Errorf("Expected Some(%d), got %v", 1+'r', got)
+		}
+		if Zip5(None[int](), b, c, d, e, func(int, string, float64, bool, rune) int { return 0 }).IsPresent() {
+			t.Error("Zip5 with None should be None")
+		}
+	})
+
+	t.Run("Zip6", func(t *testing.T) {
+		got := Zip6(a, b, c, d, e, f, func(a int, b string, c float64, d bool, e rune, f uint) uint { return uint(a) + f })
+		if !Equal(got, Some(uint(7))) {
+			t.Errorf("Expected Some(7), got %v", got)
+		}
+		if Zip6(a, b, c, d, e, None[uint](), func(int, string, float64, bool, rune, uint) int { return 0 }).IsPresent() {
+			t.Error("Zip6 with None should be None")
+		}
+	})
+}
+
+func TestZipTuples(t *testing.T) {
+	a, b, c, d, e, f := Some(1), Some("x"), Some(2.5), Some(true), Some('r'), Some(uint(6))
+
+	if got := ZipPair(a, b); !Equal(got, Some(Pair[int, string]{1, "x"})) {
+		t.Errorf("Unexpected ZipPair: %v", got)
+	}
+	if got := ZipTriple(a, b, c); !Equal(got, Some(Triple[int, string, float64]{1, "x", 2.5})) {
+		t.Errorf("Unexpected ZipTriple: %v", got)
+	}
+	if got := ZipQuadruple(a, b, c, d); got.OrElse(Quadruple[int, string, float64, bool]{}).Fourth != true {
+		t.Errorf("Unexpected ZipQuadruple: %v", got)
+	}
+	if got := ZipQuintuple(a, b, c, d, e); got.OrElse(Quintuple[int, string, float64, bool, rune]{}).Fifth != 'r' {
+		t.Errorf("Unexpected ZipQuintuple: %v", got)
+	}
+	if got := ZipSextuple(a, b, c, d, e, f); got.OrElse(Sextuple[int, string, float64, bool, rune, uint]{}).Sixth != 6 {
+		t.Errorf("Unexpected ZipSextuple: %v", got)
+	}
+	if ZipPair(a, None[string]()).IsPresent() || ZipSextuple(a, b, c, d, None[rune](), f).IsPresent() {
+		t.Error("Tuple zips with None should be None")
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	t.Run("Unzip Some", func(t *testing.T) {
+		first, second := Unzip(ZipPair(Some(1), Some("x")))
+		if !Equal(first, Some(1)) || !Equal(second, Some("x")) {
+			t.Errorf("Expected (Some(1), Some(x)), got (%v, %v)", first, second)
+		}
+	})
+
+	t.Run("Unzip None", func(t *testing.T) {
+		first, second := Unzip(None[Pair[int, string]]())
+		if first.IsPresent() || second.IsPresent() {
+			t.Errorf("Expected (None, None), got (%v, %v)", first, second)
+		}
+	})
+
+	t.Run("Unzip3", func(t *testing.T) {
+		x, y, z := Unzip3(ZipTriple(Some(1), Some(2), Some(3)))
+		if !Equal(x, Some(1)) || !Equal(y, Some(2)) || !Equal(z, Some(3)) {
+			t.Errorf("Unexpected result: %v %v %v", x, y, z)
+		}
+		x, _, _ = Unzip3(None[Triple[int, int, int]]())
+		if x.IsPresent() {
+			t.Errorf("Expected None, got %v", x)
+		}
+	})
+}
+
+func TestZipWith(t *testing.T) {
+	join := func(s []string) string { return strings.Join(s, " ") }
+	if got := ZipWith([]Optional[string]{Some("a"), Some("b"), Some("c")}, join); !Equal(got, Some("a b c")) {
+		t.Errorf("Expected Some(a b c), got %v", got)
+	}
+	if got := ZipWith([]Optional[string]{Some("a"), None[string]()}, join); got.IsPresent() {
+		t.Errorf("Expected None, got %v", got)
+	}
+}